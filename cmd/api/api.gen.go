@@ -26,246 +26,3209 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for BatchMode.
+const (
+	Atomic  BatchMode = "atomic"
+	Partial BatchMode = "partial"
+)
+
+// Defines values for CitationFormat.
+const (
+	Bibtex  CitationFormat = "bibtex"
+	CslJson CitationFormat = "csl-json"
+	Ris     CitationFormat = "ris"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv       ExportFormat = "csv"
+	Goodreads ExportFormat = "goodreads"
+	Json      ExportFormat = "json"
+)
+
+// Defines values for GoalKind.
+const (
+	Books GoalKind = "books"
+	Pages GoalKind = "pages"
+)
+
+// Defines values for GoalSchedule.
+const (
+	Ahead   GoalSchedule = "ahead"
+	Behind  GoalSchedule = "behind"
+	OnTrack GoalSchedule = "on_track"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Defines values for JobStatus.
+const (
+	Completed JobStatus = "completed"
+	Failed    JobStatus = "failed"
+	Pending   JobStatus = "pending"
+	Running   JobStatus = "running"
+)
+
+// Defines values for NoteKind.
+const (
+	Bookmark  NoteKind = "bookmark"
+	Highlight NoteKind = "highlight"
+	Note      NoteKind = "note"
+	Quote     NoteKind = "quote"
+)
+
+// Defines values for ReadingStatus.
+const (
+	Abandoned  ReadingStatus = "abandoned"
+	Finished   ReadingStatus = "finished"
+	Reading    ReadingStatus = "reading"
+	WantToRead ReadingStatus = "want_to_read"
+)
+
+// Defines values for RevisionAction.
+const (
+	Create  RevisionAction = "create"
+	Delete  RevisionAction = "delete"
+	Restore RevisionAction = "restore"
+	Update  RevisionAction = "update"
+)
+
+// Defines values for SearchMode.
+const (
+	Fuzzy     SearchMode = "fuzzy"
+	Prefix    SearchMode = "prefix"
+	Websearch SearchMode = "websearch"
+)
+
+// Defines values for TagMode.
+const (
+	All TagMode = "all"
+	Any TagMode = "any"
+)
+
+// Defines values for GetCoverHandlerParamsFile.
+const (
+	LargeJpeg    GetCoverHandlerParamsFile = "large.jpeg"
+	MediumJpeg   GetCoverHandlerParamsFile = "medium.jpeg"
+	OriginalJpeg GetCoverHandlerParamsFile = "original.jpeg"
+	OriginalPng  GetCoverHandlerParamsFile = "original.png"
+	OriginalWebp GetCoverHandlerParamsFile = "original.webp"
+	SmallJpeg    GetCoverHandlerParamsFile = "small.jpeg"
+)
+
+// AddBookTagsRequest defines model for AddBookTagsRequest.
+type AddBookTagsRequest struct {
+	// Tags The tags to add to the book. Tags are matched case-insensitively
+	Tags []string `json:"tags"`
+}
+
+// AddShelfBookRequest defines model for AddShelfBookRequest.
+type AddShelfBookRequest struct {
+	// BookId The unique identifier for the book to add to the shelf
+	BookId openapi_types.UUID `json:"book_id"`
+}
+
+// BatchBookRequest The operations of a batch, at most 100 in total and at least one
+type BatchBookRequest struct {
+	// Create The books to create
+	Create *[]CreateBookRequest `json:"create,omitempty"`
+
+	// Delete The books to delete
+	Delete *[]BatchDeleteBookRequest `json:"delete,omitempty"`
+
+	// Mode Whether a batch is saved only when all of its operations succeed (atomic) or saves the operations that succeed (partial)
+	Mode *BatchMode `json:"mode,omitempty"`
+
+	// Update The books to update
+	Update *[]BatchUpdateBookRequest `json:"update,omitempty"`
+}
+
+// BatchBookResponse defines model for BatchBookResponse.
+type BatchBookResponse struct {
+	// Committed Whether the operations that succeeded were saved
+	Committed bool              `json:"committed"`
+	Create    []BatchBookResult `json:"create"`
+	Delete    []BatchBookResult `json:"delete"`
+
+	// Failed The number of operations that failed
+	Failed int `json:"failed"`
+
+	// Mode Whether a batch is saved only when all of its operations succeed (atomic) or saves the operations that succeed (partial)
+	Mode BatchMode `json:"mode"`
+
+	// Succeeded The number of operations that succeeded
+	Succeeded int               `json:"succeeded"`
+	Update    []BatchBookResult `json:"update"`
+}
+
+// BatchBookResult defines model for BatchBookResult.
+type BatchBookResult struct {
+	Book *BookResponse `json:"book,omitempty"`
+
+	// Errors The fields of the operation that failed validation
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Index The position of the operation in its array
+	Index int `json:"index"`
+
+	// Message Why the operation failed
+	Message *string `json:"message,omitempty"`
+
+	// Status The HTTP status the operation would have had as a single request, 424 for an operation of a failed atomic batch that did not fail itself
+	Status int `json:"status"`
+}
+
+// BatchDeleteBookRequest defines model for BatchDeleteBookRequest.
+type BatchDeleteBookRequest struct {
+	// Id The unique identifier for the book
+	Id openapi_types.UUID `json:"id"`
+
+	// Version The version of the book the delete was made against
+	Version *int `json:"version,omitempty"`
+}
+
+// BatchMode Whether a batch is saved only when all of its operations succeed (atomic) or saves the operations that succeed (partial)
+type BatchMode string
+
+// BatchUpdateBookRequest defines model for BatchUpdateBookRequest.
+type BatchUpdateBookRequest struct {
+	// Author The author of the book
+	Author *string `json:"author,omitempty"`
+
+	// Genre The genre of the book
+	Genre *string `json:"genre,omitempty"`
+
+	// Id The unique identifier for the book
+	Id openapi_types.UUID `json:"id"`
+
+	// Isbn The ISBN-10 or ISBN-13 of the book, stored as ISBN-13
+	Isbn *string `json:"isbn,omitempty"`
+
+	// Language The language the book is written in
+	Language *string `json:"language,omitempty"`
+
+	// Name The name of the book
+	Name string `json:"name"`
+
+	// PageCount The number of pages in the book
+	PageCount *int `json:"page_count,omitempty"`
+
+	// PublishedYear The year the book was published
+	PublishedYear *int `json:"published_year,omitempty"`
+
+	// Publisher The publisher of the book
+	Publisher *string `json:"publisher,omitempty"`
+
+	// Version The version of the book the update was made against
+	Version *int `json:"version,omitempty"`
+}
+
+// BookMergePatch The fields of the book to change, where null removes an optional field
+type BookMergePatch struct {
+	// Author The author of the book
+	Author *string `json:"author"`
+
+	// Genre The genre of the book
+	Genre *string `json:"genre"`
+
+	// Isbn The ISBN-10 or ISBN-13 of the book, stored as ISBN-13
+	Isbn *string `json:"isbn"`
+
+	// Language The language the book is written in
+	Language *string `json:"language"`
+
+	// Name The name of the book
+	Name *string `json:"name,omitempty"`
+
+	// PageCount The number of pages in the book
+	PageCount *int `json:"page_count"`
+
+	// PublishedYear The year the book was published
+	PublishedYear *int `json:"published_year"`
+
+	// Publisher The publisher of the book
+	Publisher *string `json:"publisher"`
+}
+
 // BookResponse defines model for BookResponse.
 type BookResponse struct {
+	// Author The author of the book
+	Author *string `json:"author,omitempty"`
+
+	// AverageRating The average rating of the reviews of the book, omitted when the book has no reviews
+	AverageRating *float64 `json:"average_rating,omitempty"`
+
+	// CoverBlurhash The blurhash of the cover, a placeholder clients show while the cover loads
+	CoverBlurhash *string `json:"cover_blurhash,omitempty"`
+
+	// CoverUrls The URLs of the cover of the book and of its thumbnails, only present when it has a cover
+	CoverUrls *CoverUrls `json:"cover_urls,omitempty"`
+
 	// CreatedAt The timestamp when the book was created
 	CreatedAt time.Time `json:"created_at"`
 
+	// CurrentPage The page the user is currently on
+	CurrentPage *int `json:"current_page,omitempty"`
+
+	// FinishedAt The timestamp when the user finished or abandoned the book
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Genre The genre of the book
+	Genre *string `json:"genre,omitempty"`
+
+	// Headline The name of the book with the words matching the search wrapped in <mark> tags, only present when searching. The name is HTML escaped, so the headline can be rendered as HTML.
+	Headline *string `json:"headline,omitempty"`
+
 	// Id The unique identifier for the book
 	Id openapi_types.UUID `json:"id"`
 
+	// Isbn The ISBN-10 or ISBN-13 of the book, stored as ISBN-13
+	Isbn *string `json:"isbn,omitempty"`
+
+	// Language The language the book is written in
+	Language *string `json:"language,omitempty"`
+
 	// Name The name of the book
 	Name string `json:"name"`
 
-	// UpdatedAt The timestamp when the book was updated
+	// PageCount The number of pages in the book
+	PageCount *int `json:"page_count,omitempty"`
+
+	// ProgressPercent How much of the book has been read, as a percentage
+	ProgressPercent *int `json:"progress_percent,omitempty"`
+
+	// PublishedYear The year the book was published
+	PublishedYear *int `json:"published_year,omitempty"`
+
+	// Publisher The publisher of the book
+	Publisher *string `json:"publisher,omitempty"`
+
+	// StartedAt The timestamp when the user started reading the book
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Status The reading state of a book
+	Status ReadingStatus `json:"status"`
+
+	// Tags The tags of the book, in alphabetical order
+	Tags []string `json:"tags"`
+
+	// UpdatedAt The timestamp when the book, its tags or its reviews were last updated
 	UpdatedAt time.Time `json:"updated_at"`
 
 	// UserId The unique identifier for the book owner
 	UserId openapi_types.UUID `json:"user_id"`
+
+	// Version The version of the book, incremented every time the book is updated
+	Version int `json:"version"`
 }
 
-// CreateBookRequest defines model for CreateBookRequest.
-type CreateBookRequest struct {
+// BookRevisionResponse defines model for BookRevisionResponse.
+type BookRevisionResponse struct {
+	// Action What the change recorded by a revision did to the book
+	Action RevisionAction `json:"action"`
+
+	// Changes The fields that changed, by name
+	Changes map[string]FieldChange `json:"changes"`
+
+	// CreatedAt The timestamp when the change was made
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id The unique identifier for the revision
+	Id openapi_types.UUID `json:"id"`
+
+	// UserId The unique identifier for the user who made the change
+	UserId openapi_types.UUID `json:"user_id"`
+
+	// Version The version of the book after the change
+	Version int `json:"version"`
+}
+
+// BookSuggestion defines model for BookSuggestion.
+type BookSuggestion struct {
+	// Id The unique identifier for the book
+	Id openapi_types.UUID `json:"id"`
+
 	// Name The name of the book
 	Name string `json:"name"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	// Message A human-readable error message
-	Message string `json:"message"`
-}
+// CitationFormat The format of a citation, bibtex for BibTeX, ris for RIS or csl-json for the CSL-JSON used by citation processors such as Zotero
+type CitationFormat string
 
-// FieldError defines model for FieldError.
-type FieldError struct {
-	// Field The name of the field that caused the validation error
-	Field string `json:"field"`
+// CoverUrls The URLs of the cover of the book and of its thumbnails, only present when it has a cover
+type CoverUrls struct {
+	// Large The URL of the JPEG thumbnail 640 pixels wide
+	Large string `json:"large"`
 
-	// Message The specific error message for the field
-	Message string `json:"message"`
-}
+	// Medium The URL of the JPEG thumbnail 320 pixels wide
+	Medium string `json:"medium"`
 
-// ListBookResponse defines model for ListBookResponse.
-type ListBookResponse struct {
-	// Items A list of book
-	Items    []BookResponse `json:"items"`
-	Metadata Pagination     `json:"metadata"`
+	// Original The URL of the uploaded image
+	Original string `json:"original"`
+
+	// Small The URL of the JPEG thumbnail 160 pixels wide
+	Small string `json:"small"`
 }
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	// Email The email address of the user
-	Email openapi_types.Email `json:"email"`
+// CreateBookRequest defines model for CreateBookRequest.
+type CreateBookRequest struct {
+	// Author The author of the book
+	Author *string `json:"author,omitempty"`
 
-	// Password The password of the user
-	Password string `json:"password"`
-}
+	// Genre The genre of the book
+	Genre *string `json:"genre,omitempty"`
 
-// Pagination defines model for Pagination.
-type Pagination struct {
-	// CurrentPage The current page number
-	CurrentPage int `json:"current_page"`
+	// Isbn The ISBN-10 or ISBN-13 of the book, stored as ISBN-13
+	Isbn *string `json:"isbn,omitempty"`
 
-	// FirstPage The first page
-	FirstPage int `json:"first_page"`
+	// Language The language the book is written in
+	Language *string `json:"language,omitempty"`
 
-	// LastPage The last page
-	LastPage int `json:"last_page"`
+	// Name The name of the book
+	Name string `json:"name"`
 
-	// PageSize The number of items per page
-	PageSize int `json:"page_size"`
+	// PageCount The number of pages in the book
+	PageCount *int `json:"page_count,omitempty"`
 
-	// TotalItems The total number of items across all pages
-	TotalItems int `json:"total_items"`
+	// PublishedYear The year the book was published
+	PublishedYear *int `json:"published_year,omitempty"`
+
+	// Publisher The publisher of the book
+	Publisher *string `json:"publisher,omitempty"`
+
+	// Status The reading state of a book
+	Status *ReadingStatus `json:"status,omitempty"`
 }
 
-// RegistrationRequest defines model for RegistrationRequest.
-type RegistrationRequest struct {
-	// Email The email address of the user
-	Email openapi_types.Email `json:"email"`
+// CreateExportRequest defines model for CreateExportRequest.
+type CreateExportRequest struct {
+	// Format The format of an export, json for a JSON document, csv for a zip archive of CSV files, or goodreads for a CSV file in the format of a Goodreads library export
+	Format ExportFormat `json:"format"`
+}
 
-	// FirstName The first name of the user
-	FirstName string `json:"first_name"`
+// CreateGoalRequest defines model for CreateGoalRequest.
+type CreateGoalRequest struct {
+	// Kind What a reading goal counts, finished books or pages read
+	Kind GoalKind `json:"kind"`
 
-	// LastName The last name of the user
-	LastName string `json:"last_name"`
+	// Target The number of books to finish or pages to read in the year
+	Target int `json:"target"`
 
-	// Password The password of the user
-	Password string `json:"password"`
+	// Year The year of the goal
+	Year int `json:"year"`
 }
 
-// ResendCodeRequest defines model for ResendCodeRequest.
-type ResendCodeRequest struct {
-	// Email The email address to which the new verification code should be sent
-	Email openapi_types.Email `json:"email"`
-}
+// CreateNoteRequest defines model for CreateNoteRequest.
+type CreateNoteRequest struct {
+	// Body The text of the note, or the quoted passage. It can only be empty for a bookmark
+	Body string `json:"body"`
 
-// TokenRefreshRequest defines model for TokenRefreshRequest.
-type TokenRefreshRequest struct {
-	// RefreshToken The refresh token obtained during initial login or previous refresh
-	RefreshToken string `json:"refresh_token"`
+	// Chapter The chapter the note is anchored to
+	Chapter *string `json:"chapter,omitempty"`
+
+	// Kind The kind of a note
+	Kind *NoteKind `json:"kind,omitempty"`
+
+	// LocationEnd The end of the ebook location range the note is anchored to
+	LocationEnd *int `json:"location_end,omitempty"`
+
+	// LocationStart The start of the ebook location range the note is anchored to
+	LocationStart *int `json:"location_start,omitempty"`
+
+	// Page The page the note is anchored to
+	Page *int `json:"page,omitempty"`
 }
 
-// TokenResponse defines model for TokenResponse.
-type TokenResponse struct {
-	// AccessToken The access token used for accessing protected resources
-	AccessToken string `json:"access_token"`
+// CreateReadingSessionRequest defines model for CreateReadingSessionRequest.
+type CreateReadingSessionRequest struct {
+	// EndedAt The timestamp when the reading session ended
+	EndedAt time.Time `json:"ended_at"`
 
-	// ExpiresIn The lifetime in seconds of the access token
-	ExpiresIn int `json:"expires_in"`
+	// PagesRead The number of pages read during the session
+	PagesRead int `json:"pages_read"`
 
-	// RefreshToken The refresh token used to obtain new access token
-	RefreshToken string `json:"refresh_token"`
+	// StartedAt The timestamp when the reading session started
+	StartedAt time.Time `json:"started_at"`
+}
 
-	// TokenType The type of token issued
-	TokenType string `json:"token_type"`
+// CreateReviewRequest defines model for CreateReviewRequest.
+type CreateReviewRequest struct {
+	// Body The written review
+	Body *string `json:"body,omitempty"`
+
+	// Rating The rating of the book from 1 to 5, in steps of 0.5
+	Rating float64 `json:"rating"`
+
+	// Spoiler Whether the review reveals details of the plot
+	Spoiler *bool `json:"spoiler,omitempty"`
 }
 
-// UpdateBookRequest defines model for UpdateBookRequest.
-type UpdateBookRequest struct {
-	// Name The name of the book
+// CreateShelfRequest defines model for CreateShelfRequest.
+type CreateShelfRequest struct {
+	// Description A short description of the shelf
+	Description *string `json:"description,omitempty"`
+
+	// Name The name of the shelf
 	Name string `json:"name"`
 }
 
-// UserResponse defines model for UserResponse.
-type UserResponse struct {
-	// CreatedAt The timestamp when the user was created
+// DailyPagesRead defines model for DailyPagesRead.
+type DailyPagesRead struct {
+	Date      openapi_types.Date `json:"date"`
+	PagesRead int                `json:"pages_read"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Message A human-readable error message
+	Message string `json:"message"`
+}
+
+// ExportFormat The format of an export, json for a JSON document, csv for a zip archive of CSV files, or goodreads for a CSV file in the format of a Goodreads library export
+type ExportFormat string
+
+// ExportResponse defines model for ExportResponse.
+type ExportResponse struct {
+	// CreatedAt When the export was requested
 	CreatedAt time.Time `json:"created_at"`
 
-	// Email The email of the user
-	Email openapi_types.Email `json:"email"`
+	// DownloadUrl The URL to download the file from, once the export has completed
+	DownloadUrl *string `json:"download_url,omitempty"`
 
-	// EmailVerified Returns true if user email is verified or false if otherwise
-	EmailVerified bool `json:"email_verified"`
+	// Error Why the export failed
+	Error *string `json:"error,omitempty"`
 
-	// FirstName The first name of the user
-	FirstName string `json:"first_name"`
+	// ExpiresAt When the export and its file are deleted, once the export has finished. Omitted when exports are kept forever
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 
-	// Id The unique identifier for the user
+	// FinishedAt When the export completed or failed
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Format The format of an export, json for a JSON document, csv for a zip archive of CSV files, or goodreads for a CSV file in the format of a Goodreads library export
+	Format ExportFormat `json:"format"`
+
+	// Id The unique identifier for the export
 	Id openapi_types.UUID `json:"id"`
 
-	// LastName The last name of the user
-	LastName string `json:"last_name"`
+	// Size The size of the file in bytes, once the export has completed
+	Size *int64 `json:"size,omitempty"`
+
+	// Status The state of a background job such as an import or an export, pending until it starts, running while it works, then completed, or failed when it stopped because of an unexpected error
+	Status JobStatus `json:"status"`
 }
 
-// ValidationError defines model for ValidationError.
-type ValidationError struct {
-	// Errors A list of specific field validation errors
-	Errors []FieldError `json:"errors"`
+// FieldChange The values of a field before and after a change, null when the field had no value
+type FieldChange struct {
+	// From The value of the field before the change
+	From interface{} `json:"from"`
 
-	// Message A human-readable summary of the overall error
-	Message string `json:"message"`
+	// To The value of the field after the change
+	To interface{} `json:"to"`
 }
 
-// VerifyEmailRequest defines model for VerifyEmailRequest.
-type VerifyEmailRequest struct {
-	// Email The email address to be verified
-	Email openapi_types.Email `json:"email"`
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field The name of the field that caused the validation error
+	Field string `json:"field"`
 
-	// VerificationCode The 6-digit verification code received by the email
-	VerificationCode string `json:"verification_code"`
+	// Message The specific error message for the field
+	Message string `json:"message"`
 }
 
-// ListBookHandlerParams defines parameters for ListBookHandler.
-type ListBookHandlerParams struct {
-	Name     *string `form:"name,omitempty" json:"name,omitempty"`
-	Page     *int    `form:"page,omitempty" json:"page,omitempty"`
-	PageSize *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
+// GenreBooksFinished defines model for GenreBooksFinished.
+type GenreBooksFinished struct {
+	BooksFinished int    `json:"books_finished"`
+	Genre         string `json:"genre"`
 }
 
-// LoginUserHandlerJSONRequestBody defines body for LoginUserHandler for application/json ContentType.
-type LoginUserHandlerJSONRequestBody = LoginRequest
+// GoalKind What a reading goal counts, finished books or pages read
+type GoalKind string
 
-// RegisterUserHandlerJSONRequestBody defines body for RegisterUserHandler for application/json ContentType.
-type RegisterUserHandlerJSONRequestBody = RegistrationRequest
+// GoalResponse defines model for GoalResponse.
+type GoalResponse struct {
+	// CompletedAt The timestamp when the target was reached
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
 
-// ResendCodeHandlerJSONRequestBody defines body for ResendCodeHandler for application/json ContentType.
+	// CreatedAt The timestamp when the goal was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpectedProgress The progress needed by now to reach the target at a steady pace
+	ExpectedProgress int `json:"expected_progress"`
+
+	// Id The unique identifier for the goal
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind What a reading goal counts, finished books or pages read
+	Kind GoalKind `json:"kind"`
+
+	// Progress The number of books finished or pages read in the year so far
+	Progress int `json:"progress"`
+
+	// ProgressPercent The progress towards the target as a percentage, capped at 100
+	ProgressPercent int `json:"progress_percent"`
+
+	// ProjectedFinish The date the target is expected to be reached at the current pace, omitted when there is no progress yet
+	ProjectedFinish *openapi_types.Date `json:"projected_finish,omitempty"`
+
+	// ProjectedTotal The progress expected by the end of the year at the current pace
+	ProjectedTotal int `json:"projected_total"`
+
+	// Schedule Whether the progress of a goal is ahead of, on or behind the schedule needed to reach the target by the end of the year
+	Schedule GoalSchedule `json:"schedule"`
+
+	// Target The number of books to finish or pages to read in the year
+	Target int `json:"target"`
+
+	// UpdatedAt The timestamp when the goal was updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Year The year of the goal
+	Year int `json:"year"`
+}
+
+// GoalSchedule Whether the progress of a goal is ahead of, on or behind the schedule needed to reach the target by the end of the year
+type GoalSchedule string
+
+// ImportIssue defines model for ImportIssue.
+type ImportIssue struct {
+	// Reason Why the row was skipped or failed
+	Reason string `json:"reason"`
+
+	// Row The number of the row in the file, the header being row 1. For a Calibre library, the ID of the book in the library, and for Kindle clippings, the line the clipping starts on
+	Row int `json:"row"`
+}
+
+// ImportResponse defines model for ImportResponse.
+type ImportResponse struct {
+	// CreatedAt The timestamp when the file was uploaded
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error The error that stopped the import, only present when it failed
+	Error *string `json:"error,omitempty"`
+
+	// Failed The rows that failed
+	Failed []ImportIssue `json:"failed"`
+
+	// FinishedAt The timestamp when the import completed or failed
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Id The unique identifier for the import
+	Id openapi_types.UUID `json:"id"`
+
+	// RowsFailed The number of books that could not be imported
+	RowsFailed int `json:"rows_failed"`
+
+	// RowsImported The number of books created or merged into existing books
+	RowsImported int `json:"rows_imported"`
+
+	// RowsSkipped The number of books left out because they were already in the library
+	RowsSkipped int `json:"rows_skipped"`
+
+	// RowsTotal The number of books in the file
+	RowsTotal int `json:"rows_total"`
+
+	// Skipped The rows that were skipped
+	Skipped []ImportIssue `json:"skipped"`
+
+	// Source Where the books are imported from
+	Source string `json:"source"`
+
+	// Status The state of a background job such as an import or an export, pending until it starts, running while it works, then completed, or failed when it stopped because of an unexpected error
+	Status JobStatus `json:"status"`
+}
+
+// JSONPatch The operations to apply to the book in order
+type JSONPatch = []JSONPatchOperation
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From The JSON Pointer to the field to move or copy from
+	From *string `json:"from,omitempty"`
+
+	// Op The operation to apply
+	Op JSONPatchOperationOp `json:"op"`
+
+	// Path The JSON Pointer to the field the operation applies to
+	Path string `json:"path"`
+
+	// Value The value to add, replace or test against
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp The operation to apply
+type JSONPatchOperationOp string
+
+// JobStatus The state of a background job such as an import or an export, pending until it starts, running while it works, then completed, or failed when it stopped because of an unexpected error
+type JobStatus string
+
+// ListBookResponse defines model for ListBookResponse.
+type ListBookResponse struct {
+	// Items A list of book
+	Items    []BookResponse `json:"items"`
+	Metadata *Pagination    `json:"metadata,omitempty"`
+
+	// NextCursor The cursor to retrieve the next page of books with, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// TotalItems The total number of matching books when paging with a cursor, absent when include_total is false
+	TotalItems *int `json:"total_items,omitempty"`
+}
+
+// ListBookRevisionResponse defines model for ListBookRevisionResponse.
+type ListBookRevisionResponse struct {
+	// Items A list of revisions
+	Items    []BookRevisionResponse `json:"items"`
+	Metadata Pagination             `json:"metadata"`
+}
+
+// ListExportResponse defines model for ListExportResponse.
+type ListExportResponse struct {
+	// Items A list of exports
+	Items []ExportResponse `json:"items"`
+}
+
+// ListGoalResponse defines model for ListGoalResponse.
+type ListGoalResponse struct {
+	// Items A list of goals
+	Items []GoalResponse `json:"items"`
+}
+
+// ListNoteResponse defines model for ListNoteResponse.
+type ListNoteResponse struct {
+	// Items A list of notes
+	Items    []NoteResponse `json:"items"`
+	Metadata Pagination     `json:"metadata"`
+}
+
+// ListReadingSessionResponse defines model for ListReadingSessionResponse.
+type ListReadingSessionResponse struct {
+	// Items A list of reading sessions
+	Items    []ReadingSessionResponse `json:"items"`
+	Metadata Pagination               `json:"metadata"`
+}
+
+// ListReviewResponse defines model for ListReviewResponse.
+type ListReviewResponse struct {
+	// Items A list of reviews
+	Items    []ReviewResponse `json:"items"`
+	Metadata Pagination       `json:"metadata"`
+}
+
+// ListShelfResponse defines model for ListShelfResponse.
+type ListShelfResponse struct {
+	// Items A list of shelves
+	Items []ShelfResponse `json:"items"`
+}
+
+// ListTagResponse defines model for ListTagResponse.
+type ListTagResponse struct {
+	// Items A list of tags
+	Items []TagResponse `json:"items"`
+}
+
+// ListTrashResponse defines model for ListTrashResponse.
+type ListTrashResponse struct {
+	// Items A list of books in the trash
+	Items    []TrashedBookResponse `json:"items"`
+	Metadata Pagination            `json:"metadata"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	// Email The email address of the user
+	Email openapi_types.Email `json:"email"`
+
+	// Password The password of the user
+	Password string `json:"password"`
+}
+
+// MonthlyBooksFinished defines model for MonthlyBooksFinished.
+type MonthlyBooksFinished struct {
+	BooksFinished int `json:"books_finished"`
+	Month         int `json:"month"`
+}
+
+// NoteKind The kind of a note
+type NoteKind string
+
+// NoteResponse defines model for NoteResponse.
+type NoteResponse struct {
+	// Body The text of the note, or the quoted passage. It can only be empty for a bookmark
+	Body string `json:"body"`
+
+	// BookId The unique identifier for the book the note belongs to
+	BookId openapi_types.UUID `json:"book_id"`
+
+	// Chapter The chapter the note is anchored to
+	Chapter *string `json:"chapter,omitempty"`
+
+	// CreatedAt The timestamp when the note was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id The unique identifier for the note
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind The kind of a note
+	Kind NoteKind `json:"kind"`
+
+	// LocationEnd The end of the ebook location range the note is anchored to
+	LocationEnd *int `json:"location_end,omitempty"`
+
+	// LocationStart The start of the ebook location range the note is anchored to
+	LocationStart *int `json:"location_start,omitempty"`
+
+	// Page The page the note is anchored to
+	Page *int `json:"page,omitempty"`
+
+	// UpdatedAt The timestamp when the note was updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	// CurrentPage The current page number
+	CurrentPage int `json:"current_page"`
+
+	// FirstPage The first page
+	FirstPage int `json:"first_page"`
+
+	// LastPage The last page
+	LastPage int `json:"last_page"`
+
+	// PageSize The number of items per page
+	PageSize int `json:"page_size"`
+
+	// TotalItems The total number of items across all pages
+	TotalItems int `json:"total_items"`
+}
+
+// ReadingSessionResponse defines model for ReadingSessionResponse.
+type ReadingSessionResponse struct {
+	// BookId The unique identifier for the book that was read
+	BookId openapi_types.UUID `json:"book_id"`
+
+	// CreatedAt The timestamp when the reading session was logged
+	CreatedAt time.Time `json:"created_at"`
+
+	// EndedAt The timestamp when the reading session ended
+	EndedAt time.Time `json:"ended_at"`
+
+	// Id The unique identifier for the reading session
+	Id openapi_types.UUID `json:"id"`
+
+	// PagesRead The number of pages read during the session
+	PagesRead int `json:"pages_read"`
+
+	// StartedAt The timestamp when the reading session started
+	StartedAt time.Time `json:"started_at"`
+}
+
+// ReadingStatsResponse defines model for ReadingStatsResponse.
+type ReadingStatsResponse struct {
+	// AveragePagesPerDay The average number of pages read per day of the year so far
+	AveragePagesPerDay float64 `json:"average_pages_per_day"`
+
+	// AveragePagesPerHour The average reading speed of the year, in pages per hour of reading sessions
+	AveragePagesPerHour float64 `json:"average_pages_per_hour"`
+
+	// BooksFinishedPerMonth The number of books finished in each month of the year, from January to December
+	BooksFinishedPerMonth []MonthlyBooksFinished `json:"books_finished_per_month"`
+
+	// BooksFinishedPerYear The number of books finished in each year, across all years
+	BooksFinishedPerYear []YearlyBooksFinished `json:"books_finished_per_year"`
+
+	// Genres The number of books finished in the year per genre, most read genre first
+	Genres []GenreBooksFinished `json:"genres"`
+
+	// LongestStreak The longest run of consecutive days with at least one reading session, across all years
+	LongestStreak int `json:"longest_streak"`
+
+	// PagesPerDay The number of pages read on each day of the year that has reading sessions
+	PagesPerDay []DailyPagesRead `json:"pages_per_day"`
+
+	// PagesRead The total number of pages read in the year
+	PagesRead int `json:"pages_read"`
+
+	// Year The year the yearly statistics were calculated for
+	Year int `json:"year"`
+}
+
+// ReadingStatus The reading state of a book
+type ReadingStatus string
+
+// RegistrationRequest defines model for RegistrationRequest.
+type RegistrationRequest struct {
+	// Email The email address of the user
+	Email openapi_types.Email `json:"email"`
+
+	// FirstName The first name of the user
+	FirstName string `json:"first_name"`
+
+	// LastName The last name of the user
+	LastName string `json:"last_name"`
+
+	// Password The password of the user
+	Password string `json:"password"`
+}
+
+// ResendCodeRequest defines model for ResendCodeRequest.
+type ResendCodeRequest struct {
+	// Email The email address to which the new verification code should be sent
+	Email openapi_types.Email `json:"email"`
+}
+
+// RestoreBookRevisionRequest defines model for RestoreBookRevisionRequest.
+type RestoreBookRevisionRequest struct {
+	// Version The current version of the book
+	Version int `json:"version"`
+}
+
+// ReviewResponse defines model for ReviewResponse.
+type ReviewResponse struct {
+	// Body The written review
+	Body *string `json:"body,omitempty"`
+
+	// BookId The unique identifier for the reviewed book
+	BookId openapi_types.UUID `json:"book_id"`
+
+	// CreatedAt The timestamp when the review was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id The unique identifier for the review
+	Id openapi_types.UUID `json:"id"`
+
+	// Rating The rating of the book from 1 to 5, in steps of 0.5
+	Rating float64 `json:"rating"`
+
+	// Spoiler Whether the review reveals details of the plot
+	Spoiler bool `json:"spoiler"`
+
+	// UpdatedAt The timestamp when the review was updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId The unique identifier for the reviewer
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// RevisionAction What the change recorded by a revision did to the book
+type RevisionAction string
+
+// SearchMode How the name is searched for. websearch matches whole words, prefix also matches words that start with the searched ones, e.g "harr pot" finds "Harry Potter", and fuzzy tolerates typos by matching words that are similar to the searched ones.
+type SearchMode string
+
+// ShelfResponse defines model for ShelfResponse.
+type ShelfResponse struct {
+	// CreatedAt The timestamp when the shelf was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Description A short description of the shelf
+	Description *string `json:"description,omitempty"`
+
+	// Id The unique identifier for the shelf
+	Id openapi_types.UUID `json:"id"`
+
+	// Name The name of the shelf
+	Name string `json:"name"`
+
+	// UpdatedAt The timestamp when the shelf was updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId The unique identifier for the shelf owner
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// SuggestionsResponse defines model for SuggestionsResponse.
+type SuggestionsResponse struct {
+	// Authors The authors of the user's books that match, best match first
+	Authors []string `json:"authors"`
+
+	// Books The books whose name matches, best match first
+	Books []BookSuggestion `json:"books"`
+
+	// Tags The tags of the user that match, best match first
+	Tags []string `json:"tags"`
+}
+
+// TagMode Whether a book must have all the requested tags or any of them
+type TagMode string
+
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	// BookCount The number of books using the tag
+	BookCount int `json:"book_count"`
+
+	// Name The name of the tag
+	Name string `json:"name"`
+}
+
+// TokenRefreshRequest defines model for TokenRefreshRequest.
+type TokenRefreshRequest struct {
+	// RefreshToken The refresh token obtained during initial login or previous refresh
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	// AccessToken The access token used for accessing protected resources
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn The lifetime in seconds of the access token
+	ExpiresIn int `json:"expires_in"`
+
+	// RefreshToken The refresh token used to obtain new access token
+	RefreshToken string `json:"refresh_token"`
+
+	// TokenType The type of token issued
+	TokenType string `json:"token_type"`
+}
+
+// TrashedBookResponse defines model for TrashedBookResponse.
+type TrashedBookResponse struct {
+	Book BookResponse `json:"book"`
+
+	// DeletedAt The timestamp when the book was moved to the trash
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt The timestamp after which the book is permanently deleted, omitted when books are kept in the trash forever
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
+}
+
+// UpdateBookRequest defines model for UpdateBookRequest.
+type UpdateBookRequest struct {
+	// Author The author of the book
+	Author *string `json:"author,omitempty"`
+
+	// Genre The genre of the book
+	Genre *string `json:"genre,omitempty"`
+
+	// Isbn The ISBN-10 or ISBN-13 of the book, stored as ISBN-13
+	Isbn *string `json:"isbn,omitempty"`
+
+	// Language The language the book is written in
+	Language *string `json:"language,omitempty"`
+
+	// Name The name of the book
+	Name string `json:"name"`
+
+	// PageCount The number of pages in the book
+	PageCount *int `json:"page_count,omitempty"`
+
+	// PublishedYear The year the book was published
+	PublishedYear *int `json:"published_year,omitempty"`
+
+	// Publisher The publisher of the book
+	Publisher *string `json:"publisher,omitempty"`
+}
+
+// UpdateGoalRequest defines model for UpdateGoalRequest.
+type UpdateGoalRequest struct {
+	// Target The number of books to finish or pages to read in the year
+	Target int `json:"target"`
+}
+
+// UpdateNoteRequest defines model for UpdateNoteRequest.
+type UpdateNoteRequest struct {
+	// Body The text of the note, or the quoted passage. It can only be empty for a bookmark
+	Body string `json:"body"`
+
+	// Chapter The chapter the note is anchored to
+	Chapter *string `json:"chapter,omitempty"`
+
+	// Kind The kind of a note
+	Kind *NoteKind `json:"kind,omitempty"`
+
+	// LocationEnd The end of the ebook location range the note is anchored to
+	LocationEnd *int `json:"location_end,omitempty"`
+
+	// LocationStart The start of the ebook location range the note is anchored to
+	LocationStart *int `json:"location_start,omitempty"`
+
+	// Page The page the note is anchored to
+	Page *int `json:"page,omitempty"`
+}
+
+// UpdateReadingProgressRequest defines model for UpdateReadingProgressRequest.
+type UpdateReadingProgressRequest struct {
+	// CurrentPage The page the user is currently on
+	CurrentPage *int `json:"current_page,omitempty"`
+
+	// ProgressPercent How much of the book has been read, as a percentage
+	ProgressPercent *int `json:"progress_percent,omitempty"`
+
+	// Status The reading state of a book
+	Status *ReadingStatus `json:"status,omitempty"`
+}
+
+// UpdateReviewRequest defines model for UpdateReviewRequest.
+type UpdateReviewRequest struct {
+	// Body The written review
+	Body *string `json:"body,omitempty"`
+
+	// Rating The rating of the book from 1 to 5, in steps of 0.5
+	Rating float64 `json:"rating"`
+
+	// Spoiler Whether the review reveals details of the plot
+	Spoiler *bool `json:"spoiler,omitempty"`
+}
+
+// UpdateShelfRequest defines model for UpdateShelfRequest.
+type UpdateShelfRequest struct {
+	// Description A short description of the shelf
+	Description *string `json:"description,omitempty"`
+
+	// Name The name of the shelf
+	Name string `json:"name"`
+}
+
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	// CreatedAt The timestamp when the user was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Email The email of the user
+	Email openapi_types.Email `json:"email"`
+
+	// EmailVerified Returns true if user email is verified or false if otherwise
+	EmailVerified bool `json:"email_verified"`
+
+	// FirstName The first name of the user
+	FirstName string `json:"first_name"`
+
+	// Id The unique identifier for the user
+	Id openapi_types.UUID `json:"id"`
+
+	// LastName The last name of the user
+	LastName string `json:"last_name"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	// Errors A list of specific field validation errors
+	Errors []FieldError `json:"errors"`
+
+	// Message A human-readable summary of the overall error
+	Message string `json:"message"`
+}
+
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Email The email address to be verified
+	Email openapi_types.Email `json:"email"`
+
+	// VerificationCode The 6-digit verification code received by the email
+	VerificationCode string `json:"verification_code"`
+}
+
+// YearlyBooksFinished defines model for YearlyBooksFinished.
+type YearlyBooksFinished struct {
+	BooksFinished int `json:"books_finished"`
+	Year          int `json:"year"`
+}
+
+// ListBookHandlerParams defines parameters for ListBookHandler.
+type ListBookHandlerParams struct {
+	Name         *string             `form:"name,omitempty" json:"name,omitempty"`
+	SearchMode   *SearchMode         `form:"search_mode,omitempty" json:"search_mode,omitempty"`
+	Isbn         *string             `form:"isbn,omitempty" json:"isbn,omitempty"`
+	Status       *ReadingStatus      `form:"status,omitempty" json:"status,omitempty"`
+	ShelfId      *openapi_types.UUID `form:"shelf_id,omitempty" json:"shelf_id,omitempty"`
+	Tags         *[]string           `form:"tags,omitempty" json:"tags,omitempty"`
+	TagMode      *TagMode            `form:"tag_mode,omitempty" json:"tag_mode,omitempty"`
+	Sort         *string             `form:"sort,omitempty" json:"sort,omitempty"`
+	Page         *int                `form:"page,omitempty" json:"page,omitempty"`
+	PageSize     *int                `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Cursor       *string             `form:"cursor,omitempty" json:"cursor,omitempty"`
+	IncludeTotal *bool               `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// ListBookCitationHandlerParams defines parameters for ListBookCitationHandler.
+type ListBookCitationHandlerParams struct {
+	Format     *CitationFormat     `form:"format,omitempty" json:"format,omitempty"`
+	Name       *string             `form:"name,omitempty" json:"name,omitempty"`
+	SearchMode *SearchMode         `form:"search_mode,omitempty" json:"search_mode,omitempty"`
+	Isbn       *string             `form:"isbn,omitempty" json:"isbn,omitempty"`
+	Status     *ReadingStatus      `form:"status,omitempty" json:"status,omitempty"`
+	ShelfId    *openapi_types.UUID `form:"shelf_id,omitempty" json:"shelf_id,omitempty"`
+	Tags       *[]string           `form:"tags,omitempty" json:"tags,omitempty"`
+	TagMode    *TagMode            `form:"tag_mode,omitempty" json:"tag_mode,omitempty"`
+	Sort       *string             `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// SuggestBookHandlerParams defines parameters for SuggestBookHandler.
+type SuggestBookHandlerParams struct {
+	Q     string `form:"q" json:"q"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeleteBookHandlerParams defines parameters for DeleteBookHandler.
+type DeleteBookHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PatchBookHandlerParams defines parameters for PatchBookHandler.
+type PatchBookHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateBookHandlerParams defines parameters for UpdateBookHandler.
+type UpdateBookHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetBookCitationHandlerParams defines parameters for GetBookCitationHandler.
+type GetBookCitationHandlerParams struct {
+	Format *CitationFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PutBookCoverHandlerParams defines parameters for PutBookCoverHandler.
+type PutBookCoverHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// ListBookHistoryHandlerParams defines parameters for ListBookHistoryHandler.
+type ListBookHistoryHandlerParams struct {
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// RestoreBookRevisionHandlerParams defines parameters for RestoreBookRevisionHandler.
+type RestoreBookRevisionHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// ListNoteHandlerParams defines parameters for ListNoteHandler.
+type ListNoteHandlerParams struct {
+	Search   *string   `form:"search,omitempty" json:"search,omitempty"`
+	Kind     *NoteKind `form:"kind,omitempty" json:"kind,omitempty"`
+	Page     *int      `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int      `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// UpdateReadingProgressHandlerParams defines parameters for UpdateReadingProgressHandler.
+type UpdateReadingProgressHandlerParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// ListBookReviewHandlerParams defines parameters for ListBookReviewHandler.
+type ListBookReviewHandlerParams struct {
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListReadingSessionHandlerParams defines parameters for ListReadingSessionHandler.
+type ListReadingSessionHandlerParams struct {
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// GetCoverHandlerParamsFile defines parameters for GetCoverHandler.
+type GetCoverHandlerParamsFile string
+
+// ListGoalHandlerParams defines parameters for ListGoalHandler.
+type ListGoalHandlerParams struct {
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// ImportCalibreHandlerMultipartBody defines parameters for ImportCalibreHandler.
+type ImportCalibreHandlerMultipartBody struct {
+	// File The metadata.db file of the Calibre library, at most 10MB
+	File openapi_types.File `json:"file"`
+}
+
+// ImportGoodreadsHandlerMultipartBody defines parameters for ImportGoodreadsHandler.
+type ImportGoodreadsHandlerMultipartBody struct {
+	// File The goodreads_library_export.csv file, at most 10MB
+	File openapi_types.File `json:"file"`
+}
+
+// ImportKindleHandlerMultipartBody defines parameters for ImportKindleHandler.
+type ImportKindleHandlerMultipartBody struct {
+	// File The My Clippings.txt file of the Kindle, at most 10MB
+	File openapi_types.File `json:"file"`
+}
+
+// ListShelfBookHandlerParams defines parameters for ListShelfBookHandler.
+type ListShelfBookHandlerParams struct {
+	Page         *int    `form:"page,omitempty" json:"page,omitempty"`
+	PageSize     *int    `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Cursor       *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	IncludeTotal *bool   `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// ListShelfCitationHandlerParams defines parameters for ListShelfCitationHandler.
+type ListShelfCitationHandlerParams struct {
+	Format *CitationFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListTrashHandlerParams defines parameters for ListTrashHandler.
+type ListTrashHandlerParams struct {
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// GetUserStatsHandlerParams defines parameters for GetUserStatsHandler.
+type GetUserStatsHandlerParams struct {
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+}
+
+// LoginUserHandlerJSONRequestBody defines body for LoginUserHandler for application/json ContentType.
+type LoginUserHandlerJSONRequestBody = LoginRequest
+
+// RegisterUserHandlerJSONRequestBody defines body for RegisterUserHandler for application/json ContentType.
+type RegisterUserHandlerJSONRequestBody = RegistrationRequest
+
+// ResendCodeHandlerJSONRequestBody defines body for ResendCodeHandler for application/json ContentType.
 type ResendCodeHandlerJSONRequestBody = ResendCodeRequest
 
-// VerifyEmailHandlerJSONRequestBody defines body for VerifyEmailHandler for application/json ContentType.
-type VerifyEmailHandlerJSONRequestBody = VerifyEmailRequest
+// VerifyEmailHandlerJSONRequestBody defines body for VerifyEmailHandler for application/json ContentType.
+type VerifyEmailHandlerJSONRequestBody = VerifyEmailRequest
+
+// CreateBookHandlerJSONRequestBody defines body for CreateBookHandler for application/json ContentType.
+type CreateBookHandlerJSONRequestBody = CreateBookRequest
+
+// BatchBookHandlerJSONRequestBody defines body for BatchBookHandler for application/json ContentType.
+type BatchBookHandlerJSONRequestBody = BatchBookRequest
+
+// PatchBookHandlerApplicationJSONPatchPlusJSONRequestBody defines body for PatchBookHandler for application/json-patch+json ContentType.
+type PatchBookHandlerApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// PatchBookHandlerApplicationMergePatchPlusJSONRequestBody defines body for PatchBookHandler for application/merge-patch+json ContentType.
+type PatchBookHandlerApplicationMergePatchPlusJSONRequestBody = BookMergePatch
+
+// UpdateBookHandlerJSONRequestBody defines body for UpdateBookHandler for application/json ContentType.
+type UpdateBookHandlerJSONRequestBody = UpdateBookRequest
+
+// RestoreBookRevisionHandlerJSONRequestBody defines body for RestoreBookRevisionHandler for application/json ContentType.
+type RestoreBookRevisionHandlerJSONRequestBody = RestoreBookRevisionRequest
+
+// CreateNoteHandlerJSONRequestBody defines body for CreateNoteHandler for application/json ContentType.
+type CreateNoteHandlerJSONRequestBody = CreateNoteRequest
+
+// UpdateNoteHandlerJSONRequestBody defines body for UpdateNoteHandler for application/json ContentType.
+type UpdateNoteHandlerJSONRequestBody = UpdateNoteRequest
+
+// UpdateReadingProgressHandlerJSONRequestBody defines body for UpdateReadingProgressHandler for application/json ContentType.
+type UpdateReadingProgressHandlerJSONRequestBody = UpdateReadingProgressRequest
+
+// CreateReviewHandlerJSONRequestBody defines body for CreateReviewHandler for application/json ContentType.
+type CreateReviewHandlerJSONRequestBody = CreateReviewRequest
+
+// CreateReadingSessionHandlerJSONRequestBody defines body for CreateReadingSessionHandler for application/json ContentType.
+type CreateReadingSessionHandlerJSONRequestBody = CreateReadingSessionRequest
+
+// AddBookTagsHandlerJSONRequestBody defines body for AddBookTagsHandler for application/json ContentType.
+type AddBookTagsHandlerJSONRequestBody = AddBookTagsRequest
+
+// CreateExportHandlerJSONRequestBody defines body for CreateExportHandler for application/json ContentType.
+type CreateExportHandlerJSONRequestBody = CreateExportRequest
+
+// CreateGoalHandlerJSONRequestBody defines body for CreateGoalHandler for application/json ContentType.
+type CreateGoalHandlerJSONRequestBody = CreateGoalRequest
+
+// UpdateGoalHandlerJSONRequestBody defines body for UpdateGoalHandler for application/json ContentType.
+type UpdateGoalHandlerJSONRequestBody = UpdateGoalRequest
+
+// ImportCalibreHandlerMultipartRequestBody defines body for ImportCalibreHandler for multipart/form-data ContentType.
+type ImportCalibreHandlerMultipartRequestBody ImportCalibreHandlerMultipartBody
+
+// ImportGoodreadsHandlerMultipartRequestBody defines body for ImportGoodreadsHandler for multipart/form-data ContentType.
+type ImportGoodreadsHandlerMultipartRequestBody ImportGoodreadsHandlerMultipartBody
+
+// ImportKindleHandlerMultipartRequestBody defines body for ImportKindleHandler for multipart/form-data ContentType.
+type ImportKindleHandlerMultipartRequestBody ImportKindleHandlerMultipartBody
+
+// UpdateReviewHandlerJSONRequestBody defines body for UpdateReviewHandler for application/json ContentType.
+type UpdateReviewHandlerJSONRequestBody = UpdateReviewRequest
+
+// CreateShelfHandlerJSONRequestBody defines body for CreateShelfHandler for application/json ContentType.
+type CreateShelfHandlerJSONRequestBody = CreateShelfRequest
+
+// UpdateShelfHandlerJSONRequestBody defines body for UpdateShelfHandler for application/json ContentType.
+type UpdateShelfHandlerJSONRequestBody = UpdateShelfRequest
+
+// AddShelfBookHandlerJSONRequestBody defines body for AddShelfBookHandler for application/json ContentType.
+type AddShelfBookHandlerJSONRequestBody = AddShelfBookRequest
+
+// RefreshTokenHandlerJSONRequestBody defines body for RefreshTokenHandler for application/json ContentType.
+type RefreshTokenHandlerJSONRequestBody = TokenRefreshRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Log in a user
+	// (POST /auth/login)
+	LoginUserHandler(w http.ResponseWriter, r *http.Request)
+	// Register a new user
+	// (POST /auth/registration)
+	RegisterUserHandler(w http.ResponseWriter, r *http.Request)
+	// Resend email verification code
+	// (POST /auth/resend-code)
+	ResendCodeHandler(w http.ResponseWriter, r *http.Request)
+	// Verify user email address
+	// (POST /auth/verify-email)
+	VerifyEmailHandler(w http.ResponseWriter, r *http.Request)
+	// Retrieve all books that belongs to the user
+	// (GET /books)
+	ListBookHandler(w http.ResponseWriter, r *http.Request, params ListBookHandlerParams)
+	// Create a new book
+	// (POST /books)
+	CreateBookHandler(w http.ResponseWriter, r *http.Request)
+	// Create, update and delete books in a single request
+	// (POST /books/batch)
+	BatchBookHandler(w http.ResponseWriter, r *http.Request)
+	// Retrieve the citations of the books matching a search
+	// (GET /books/citations)
+	ListBookCitationHandler(w http.ResponseWriter, r *http.Request, params ListBookCitationHandlerParams)
+	// Suggest book names, authors and tags matching what the user is typing
+	// (GET /books/suggest)
+	SuggestBookHandler(w http.ResponseWriter, r *http.Request, params SuggestBookHandlerParams)
+	// Delete a specific book that belongs to the user by ID
+	// (DELETE /books/{id})
+	DeleteBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteBookHandlerParams)
+	// Get a specific book that belongs to the user by ID
+	// (GET /books/{id})
+	GetBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Partially update a specific book that belongs to the user by ID
+	// (PATCH /books/{id})
+	PatchBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PatchBookHandlerParams)
+	// Update a specific book that belongs to the user by ID
+	// (PUT /books/{id})
+	UpdateBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateBookHandlerParams)
+	// Retrieve the citation of a book
+	// (GET /books/{id}/citation)
+	GetBookCitationHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetBookCitationHandlerParams)
+	// Upload the cover image of a specific book
+	// (PUT /books/{id}/cover)
+	PutBookCoverHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutBookCoverHandlerParams)
+	// Retrieve the edit history of a specific book
+	// (GET /books/{id}/history)
+	ListBookHistoryHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListBookHistoryHandlerParams)
+	// Restore a specific book to one of its revisions
+	// (POST /books/{id}/history/{revisionId}/restore)
+	RestoreBookRevisionHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, revisionId openapi_types.UUID, params RestoreBookRevisionHandlerParams)
+	// Retrieve the notes of a specific book
+	// (GET /books/{id}/notes)
+	ListNoteHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListNoteHandlerParams)
+	// Save a note, quote or highlight against a specific book
+	// (POST /books/{id}/notes)
+	CreateNoteHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Delete a specific note of a book by ID
+	// (DELETE /books/{id}/notes/{noteId})
+	DeleteNoteHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID)
+	// Get a specific note of a book by ID
+	// (GET /books/{id}/notes/{noteId})
+	GetNoteHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID)
+	// Update a specific note of a book by ID
+	// (PUT /books/{id}/notes/{noteId})
+	UpdateNoteHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID)
+	// Update the reading status and progress of a specific book
+	// (PUT /books/{id}/progress)
+	UpdateReadingProgressHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params UpdateReadingProgressHandlerParams)
+	// Retrieve all reviews of a specific book
+	// (GET /books/{id}/reviews)
+	ListBookReviewHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListBookReviewHandlerParams)
+	// Review a specific book
+	// (POST /books/{id}/reviews)
+	CreateReviewHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retrieve the reading sessions of a specific book
+	// (GET /books/{id}/sessions)
+	ListReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListReadingSessionHandlerParams)
+	// Log a reading session of a specific book
+	// (POST /books/{id}/sessions)
+	CreateReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Delete a specific reading session of a book by ID
+	// (DELETE /books/{id}/sessions/{sessionId})
+	DeleteReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, sessionId openapi_types.UUID)
+	// Add tags to a specific book
+	// (POST /books/{id}/tags)
+	AddBookTagsHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove a tag from a specific book
+	// (DELETE /books/{id}/tags/{tag})
+	RemoveBookTagHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, tag string)
+	// Get the image of a book cover or one of its thumbnails
+	// (GET /covers/{key}/{file})
+	GetCoverHandler(w http.ResponseWriter, r *http.Request, key string, file GetCoverHandlerParamsFile)
+	// Retrieve all exports of the user
+	// (GET /exports)
+	ListExportHandler(w http.ResponseWriter, r *http.Request)
+	// Start an export of the library
+	// (POST /exports)
+	CreateExportHandler(w http.ResponseWriter, r *http.Request)
+	// Retrieve the status of an export
+	// (GET /exports/{id})
+	GetExportHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Download the file of an export
+	// (GET /exports/{id}/download)
+	DownloadExportHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retrieve the reading goals of the user with their progress
+	// (GET /goals)
+	ListGoalHandler(w http.ResponseWriter, r *http.Request, params ListGoalHandlerParams)
+	// Set a reading goal for a year
+	// (POST /goals)
+	CreateGoalHandler(w http.ResponseWriter, r *http.Request)
+	// Delete a specific goal by ID
+	// (DELETE /goals/{id})
+	DeleteGoalHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a specific goal with its progress by ID
+	// (GET /goals/{id})
+	GetGoalHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update the target of a specific goal by ID
+	// (PUT /goals/{id})
+	UpdateGoalHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Import a Calibre library
+	// (POST /imports/calibre)
+	ImportCalibreHandler(w http.ResponseWriter, r *http.Request)
+	// Import a Goodreads library export
+	// (POST /imports/goodreads)
+	ImportGoodreadsHandler(w http.ResponseWriter, r *http.Request)
+	// Import the clippings of a Kindle
+	// (POST /imports/kindle)
+	ImportKindleHandler(w http.ResponseWriter, r *http.Request)
+	// Retrieve the status of an import
+	// (GET /imports/{id})
+	GetImportHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Delete a specific review that belongs to the user by ID
+	// (DELETE /reviews/{id})
+	DeleteReviewHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a specific review that belongs to the user by ID
+	// (GET /reviews/{id})
+	GetReviewHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a specific review that belongs to the user by ID
+	// (PUT /reviews/{id})
+	UpdateReviewHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retrieve all shelves that belongs to the user
+	// (GET /shelves)
+	ListShelfHandler(w http.ResponseWriter, r *http.Request)
+	// Create a new shelf
+	// (POST /shelves)
+	CreateShelfHandler(w http.ResponseWriter, r *http.Request)
+	// Delete a specific shelf that belongs to the user by ID
+	// (DELETE /shelves/{id})
+	DeleteShelfHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a specific shelf that belongs to the user by ID
+	// (GET /shelves/{id})
+	GetShelfHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a specific shelf that belongs to the user by ID
+	// (PUT /shelves/{id})
+	UpdateShelfHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retrieve all books on a specific shelf
+	// (GET /shelves/{id}/books)
+	ListShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListShelfBookHandlerParams)
+	// Add a book to a specific shelf
+	// (POST /shelves/{id}/books)
+	AddShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove a book from a specific shelf
+	// (DELETE /shelves/{id}/books/{bookId})
+	RemoveShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, bookId openapi_types.UUID)
+	// Retrieve the citations of the books on a shelf
+	// (GET /shelves/{id}/citations)
+	ListShelfCitationHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListShelfCitationHandlerParams)
+	// Retrieve all tags of the user with the number of books using each tag
+	// (GET /tags)
+	ListTagHandler(w http.ResponseWriter, r *http.Request)
+	// Refresh access token
+	// (POST /token/refresh)
+	RefreshTokenHandler(w http.ResponseWriter, r *http.Request)
+	// Retrieve the books in the trash of the user
+	// (GET /trash)
+	ListTrashHandler(w http.ResponseWriter, r *http.Request, params ListTrashHandlerParams)
+	// Permanently delete a book in the trash
+	// (DELETE /trash/{id})
+	DeleteTrashedBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Restore a book from the trash
+	// (POST /trash/{id}/restore)
+	RestoreTrashedBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Retrieve the reading statistics of the authenticated user
+	// (GET /users/me/stats)
+	GetUserStatsHandler(w http.ResponseWriter, r *http.Request, params GetUserStatsHandlerParams)
+	// Get user profile by ID
+	// (GET /users/{id})
+	GetUserHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// LoginUserHandler operation middleware
+func (siw *ServerInterfaceWrapper) LoginUserHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoginUserHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RegisterUserHandler operation middleware
+func (siw *ServerInterfaceWrapper) RegisterUserHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterUserHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResendCodeHandler operation middleware
+func (siw *ServerInterfaceWrapper) ResendCodeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResendCodeHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// VerifyEmailHandler operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEmailHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyEmailHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBookHandlerParams
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_mode", r.URL.Query(), &params.SearchMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isbn" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isbn", r.URL.Query(), &params.Isbn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isbn", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "shelf_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "shelf_id", r.URL.Query(), &params.ShelfId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shelf_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_mode", r.URL.Query(), &params.TagMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBookHandler(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBookHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// BatchBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) BatchBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchBookHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBookCitationHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListBookCitationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBookCitationHandlerParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_mode", r.URL.Query(), &params.SearchMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "isbn" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "isbn", r.URL.Query(), &params.Isbn)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isbn", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "shelf_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "shelf_id", r.URL.Query(), &params.ShelfId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shelf_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_mode", r.URL.Query(), &params.TagMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBookCitationHandler(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SuggestBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) SuggestBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestBookHandlerParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestBookHandler(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBookHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBookHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBookHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) PatchBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchBookHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchBookHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateBookHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBookHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBookCitationHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetBookCitationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBookCitationHandlerParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBookCitationHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBookCoverHandler operation middleware
+func (siw *ServerInterfaceWrapper) PutBookCoverHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBookCoverHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBookCoverHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBookHistoryHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListBookHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBookHistoryHandlerParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBookHistoryHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RestoreBookRevisionHandler operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBookRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revisionId" -------------
+	var revisionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revisionId", r.PathValue("revisionId"), &revisionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revisionId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreBookRevisionHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreBookRevisionHandler(w, r, id, revisionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListNoteHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListNoteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNoteHandlerParams
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNoteHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateNoteHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateNoteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNoteHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteNoteHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNoteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "noteId" -------------
+	var noteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", r.PathValue("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNoteHandler(w, r, id, noteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetNoteHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetNoteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "noteId" -------------
+	var noteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", r.PathValue("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNoteHandler(w, r, id, noteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateNoteHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNoteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "noteId" -------------
+	var noteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", r.PathValue("noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNoteHandler(w, r, id, noteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateReadingProgressHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReadingProgressHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateReadingProgressHandlerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateReadingProgressHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBookReviewHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListBookReviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBookReviewHandlerParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBookReviewHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateReviewHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateReviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReviewHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListReadingSessionHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListReadingSessionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReadingSessionHandlerParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReadingSessionHandler(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateReadingSessionHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateReadingSessionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReadingSessionHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteReadingSessionHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReadingSessionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteReadingSessionHandler(w, r, id, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddBookTagsHandler operation middleware
+func (siw *ServerInterfaceWrapper) AddBookTagsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddBookTagsHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RemoveBookTagHandler operation middleware
+func (siw *ServerInterfaceWrapper) RemoveBookTagHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", r.PathValue("tag"), &tag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveBookTagHandler(w, r, id, tag)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCoverHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetCoverHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "file" -------------
+	var file GetCoverHandlerParamsFile
+
+	err = runtime.BindStyledParameterWithOptions("simple", "file", r.PathValue("file"), &file, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCoverHandler(w, r, key, file)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListExportHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExportHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateExportHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExportHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetExportHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DownloadExportHandler operation middleware
+func (siw *ServerInterfaceWrapper) DownloadExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadExportHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListGoalHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListGoalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGoalHandlerParams
 
-// CreateBookHandlerJSONRequestBody defines body for CreateBookHandler for application/json ContentType.
-type CreateBookHandlerJSONRequestBody = CreateBookRequest
+	// ------------- Optional query parameter "year" -------------
 
-// UpdateBookHandlerJSONRequestBody defines body for UpdateBookHandler for application/json ContentType.
-type UpdateBookHandlerJSONRequestBody = UpdateBookRequest
+	err = runtime.BindQueryParameter("form", true, false, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
 
-// RefreshTokenHandlerJSONRequestBody defines body for RefreshTokenHandler for application/json ContentType.
-type RefreshTokenHandlerJSONRequestBody = TokenRefreshRequest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGoalHandler(w, r, params)
+	}))
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Log in a user
-	// (POST /auth/login)
-	LoginUserHandler(w http.ResponseWriter, r *http.Request)
-	// Register a new user
-	// (POST /auth/registration)
-	RegisterUserHandler(w http.ResponseWriter, r *http.Request)
-	// Resend email verification code
-	// (POST /auth/resend-code)
-	ResendCodeHandler(w http.ResponseWriter, r *http.Request)
-	// Verify user email address
-	// (POST /auth/verify-email)
-	VerifyEmailHandler(w http.ResponseWriter, r *http.Request)
-	// Retrieve all books that belongs to the user
-	// (GET /books)
-	ListBookHandler(w http.ResponseWriter, r *http.Request, params ListBookHandlerParams)
-	// Create a new book
-	// (POST /books)
-	CreateBookHandler(w http.ResponseWriter, r *http.Request)
-	// Delete a specific book that belongs to the user by ID
-	// (DELETE /books/{id})
-	DeleteBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Get a specific book that belongs to the user by ID
-	// (GET /books/{id})
-	GetBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Update a specific book that belongs to the user by ID
-	// (PUT /books/{id})
-	UpdateBookHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Refresh access token
-	// (POST /token/refresh)
-	RefreshTokenHandler(w http.ResponseWriter, r *http.Request)
-	// Get user profile by ID
-	// (GET /users/{id})
-	GetUserHandler(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateGoalHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateGoalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGoalHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteGoalHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGoalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGoalHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetGoalHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetGoalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGoalHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateGoalHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGoalHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGoalHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportCalibreHandler operation middleware
+func (siw *ServerInterfaceWrapper) ImportCalibreHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCalibreHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportGoodreadsHandler operation middleware
+func (siw *ServerInterfaceWrapper) ImportGoodreadsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportGoodreadsHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportKindleHandler operation middleware
+func (siw *ServerInterfaceWrapper) ImportKindleHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportKindleHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetImportHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetImportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImportHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteReviewHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteReviewHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// GetReviewHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReviewHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// UpdateReviewHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// LoginUserHandler operation middleware
-func (siw *ServerInterfaceWrapper) LoginUserHandler(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateReviewHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListShelfHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListShelfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LoginUserHandler(w, r)
+		siw.Handler.ListShelfHandler(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -275,12 +3238,14 @@ func (siw *ServerInterfaceWrapper) LoginUserHandler(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RegisterUserHandler operation middleware
-func (siw *ServerInterfaceWrapper) RegisterUserHandler(w http.ResponseWriter, r *http.Request) {
+// CreateShelfHandler operation middleware
+func (siw *ServerInterfaceWrapper) CreateShelfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterUserHandler(w, r)
+		siw.Handler.CreateShelfHandler(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -290,12 +3255,25 @@ func (siw *ServerInterfaceWrapper) RegisterUserHandler(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResendCodeHandler operation middleware
-func (siw *ServerInterfaceWrapper) ResendCodeHandler(w http.ResponseWriter, r *http.Request) {
+// DeleteShelfHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteShelfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResendCodeHandler(w, r)
+		siw.Handler.DeleteShelfHandler(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -305,12 +3283,25 @@ func (siw *ServerInterfaceWrapper) ResendCodeHandler(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VerifyEmailHandler operation middleware
-func (siw *ServerInterfaceWrapper) VerifyEmailHandler(w http.ResponseWriter, r *http.Request) {
+// GetShelfHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetShelfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VerifyEmailHandler(w, r)
+		siw.Handler.GetShelfHandler(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -320,25 +3311,54 @@ func (siw *ServerInterfaceWrapper) VerifyEmailHandler(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListBookHandler operation middleware
-func (siw *ServerInterfaceWrapper) ListBookHandler(w http.ResponseWriter, r *http.Request) {
+// UpdateShelfHandler operation middleware
+func (siw *ServerInterfaceWrapper) UpdateShelfHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBookHandlerParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateShelfHandler(w, r, id)
+	}))
 
-	// ------------- Optional query parameter "name" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListShelfBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListShelfBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListShelfBookHandlerParams
+
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
@@ -355,8 +3375,24 @@ func (siw *ServerInterfaceWrapper) ListBookHandler(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBookHandler(w, r, params)
+		siw.Handler.ListShelfBookHandler(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -366,14 +3402,62 @@ func (siw *ServerInterfaceWrapper) ListBookHandler(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateBookHandler operation middleware
-func (siw *ServerInterfaceWrapper) CreateBookHandler(w http.ResponseWriter, r *http.Request) {
+// AddShelfBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) AddShelfBookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddShelfBookHandler(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RemoveShelfBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) RemoveShelfBookHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "bookId" -------------
+	var bookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookId", r.PathValue("bookId"), &bookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bookId", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateBookHandler(w, r)
+		siw.Handler.RemoveShelfBookHandler(w, r, id, bookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -383,8 +3467,8 @@ func (siw *ServerInterfaceWrapper) CreateBookHandler(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteBookHandler operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBookHandler(w http.ResponseWriter, r *http.Request) {
+// ListShelfCitationHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListShelfCitationHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -400,8 +3484,19 @@ func (siw *ServerInterfaceWrapper) DeleteBookHandler(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListShelfCitationHandlerParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBookHandler(w, r, id)
+		siw.Handler.ListShelfCitationHandler(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -411,8 +3506,78 @@ func (siw *ServerInterfaceWrapper) DeleteBookHandler(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBookHandler operation middleware
-func (siw *ServerInterfaceWrapper) GetBookHandler(w http.ResponseWriter, r *http.Request) {
+// ListTagHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListTagHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTagHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RefreshTokenHandler operation middleware
+func (siw *ServerInterfaceWrapper) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshTokenHandler(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListTrashHandler operation middleware
+func (siw *ServerInterfaceWrapper) ListTrashHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashHandlerParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrashHandler(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTrashedBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrashedBookHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -429,7 +3594,7 @@ func (siw *ServerInterfaceWrapper) GetBookHandler(w http.ResponseWriter, r *http
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBookHandler(w, r, id)
+		siw.Handler.DeleteTrashedBookHandler(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -439,8 +3604,8 @@ func (siw *ServerInterfaceWrapper) GetBookHandler(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateBookHandler operation middleware
-func (siw *ServerInterfaceWrapper) UpdateBookHandler(w http.ResponseWriter, r *http.Request) {
+// RestoreTrashedBookHandler operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTrashedBookHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -457,7 +3622,7 @@ func (siw *ServerInterfaceWrapper) UpdateBookHandler(w http.ResponseWriter, r *h
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateBookHandler(w, r, id)
+		siw.Handler.RestoreTrashedBookHandler(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -467,12 +3632,27 @@ func (siw *ServerInterfaceWrapper) UpdateBookHandler(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RefreshTokenHandler operation middleware
-func (siw *ServerInterfaceWrapper) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
+// GetUserStatsHandler operation middleware
+func (siw *ServerInterfaceWrapper) GetUserStatsHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserStatsHandlerParams
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RefreshTokenHandler(w, r)
+		siw.Handler.GetUserStatsHandler(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -630,10 +3810,62 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/auth/verify-email", wrapper.VerifyEmailHandler)
 	m.HandleFunc("GET "+options.BaseURL+"/books", wrapper.ListBookHandler)
 	m.HandleFunc("POST "+options.BaseURL+"/books", wrapper.CreateBookHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/batch", wrapper.BatchBookHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/citations", wrapper.ListBookCitationHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/suggest", wrapper.SuggestBookHandler)
 	m.HandleFunc("DELETE "+options.BaseURL+"/books/{id}", wrapper.DeleteBookHandler)
 	m.HandleFunc("GET "+options.BaseURL+"/books/{id}", wrapper.GetBookHandler)
+	m.HandleFunc("PATCH "+options.BaseURL+"/books/{id}", wrapper.PatchBookHandler)
 	m.HandleFunc("PUT "+options.BaseURL+"/books/{id}", wrapper.UpdateBookHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/citation", wrapper.GetBookCitationHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/books/{id}/cover", wrapper.PutBookCoverHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/history", wrapper.ListBookHistoryHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/{id}/history/{revisionId}/restore", wrapper.RestoreBookRevisionHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/notes", wrapper.ListNoteHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/{id}/notes", wrapper.CreateNoteHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/books/{id}/notes/{noteId}", wrapper.DeleteNoteHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/notes/{noteId}", wrapper.GetNoteHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/books/{id}/notes/{noteId}", wrapper.UpdateNoteHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/books/{id}/progress", wrapper.UpdateReadingProgressHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/reviews", wrapper.ListBookReviewHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/{id}/reviews", wrapper.CreateReviewHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/books/{id}/sessions", wrapper.ListReadingSessionHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/{id}/sessions", wrapper.CreateReadingSessionHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/books/{id}/sessions/{sessionId}", wrapper.DeleteReadingSessionHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/books/{id}/tags", wrapper.AddBookTagsHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/books/{id}/tags/{tag}", wrapper.RemoveBookTagHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/covers/{key}/{file}", wrapper.GetCoverHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/exports", wrapper.ListExportHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/exports", wrapper.CreateExportHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/exports/{id}", wrapper.GetExportHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/exports/{id}/download", wrapper.DownloadExportHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/goals", wrapper.ListGoalHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/goals", wrapper.CreateGoalHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/goals/{id}", wrapper.DeleteGoalHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/goals/{id}", wrapper.GetGoalHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/goals/{id}", wrapper.UpdateGoalHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/imports/calibre", wrapper.ImportCalibreHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/imports/goodreads", wrapper.ImportGoodreadsHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/imports/kindle", wrapper.ImportKindleHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/imports/{id}", wrapper.GetImportHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/reviews/{id}", wrapper.DeleteReviewHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/reviews/{id}", wrapper.GetReviewHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/reviews/{id}", wrapper.UpdateReviewHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/shelves", wrapper.ListShelfHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/shelves", wrapper.CreateShelfHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/shelves/{id}", wrapper.DeleteShelfHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/shelves/{id}", wrapper.GetShelfHandler)
+	m.HandleFunc("PUT "+options.BaseURL+"/shelves/{id}", wrapper.UpdateShelfHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/shelves/{id}/books", wrapper.ListShelfBookHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/shelves/{id}/books", wrapper.AddShelfBookHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/shelves/{id}/books/{bookId}", wrapper.RemoveShelfBookHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/shelves/{id}/citations", wrapper.ListShelfCitationHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/tags", wrapper.ListTagHandler)
 	m.HandleFunc("POST "+options.BaseURL+"/token/refresh", wrapper.RefreshTokenHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/trash", wrapper.ListTrashHandler)
+	m.HandleFunc("DELETE "+options.BaseURL+"/trash/{id}", wrapper.DeleteTrashedBookHandler)
+	m.HandleFunc("POST "+options.BaseURL+"/trash/{id}/restore", wrapper.RestoreTrashedBookHandler)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me/stats", wrapper.GetUserStatsHandler)
 	m.HandleFunc("GET "+options.BaseURL+"/users/{id}", wrapper.GetUserHandler)
 
 	return m
@@ -642,52 +3874,285 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbe2/bthb/KoTugK2AYzvPtv7rtku6m2HrhrQdcNHmGrR0bLGVSJWknHqBv/sFDyVZ",
-	"D8p2EjnN2vyXWNR58ZzfeZC69nwRJ4ID18obXXvKDyGm+OdLIT5dgEoEV2D+T6RIQGoG+NSXQDUEY6rN",
-	"fwEoX7JEM8G9kfc2BKJZDErTOCFXIXCiQyATIT6RK6pI9q7X86ZCxoaCF1ANe+Ydr+fpRQLeyFNaMj7z",
-	"lj2PBW4eKWefUyAsAK7ZlIEkUyELVl7Pgy80TiJD7HgIJwf7x8He5Ng/2Tt69vxk7/mzp/7e9HA4PTx5",
-	"+mx6MnxWlidNWeAShdMY3MKYJ0RM3ewvzt68JTRhJADFZtxFOU2CWxs0e3drg6YK5Ph2ViXiioPcgW2X",
-	"PU/C55RJCLzRew+XoLVX4vbKXlex2GVBTkw+gq+Nkj/jWuvFn1NQuunEu9rNmi7IxiXimZRCNsWKQSk6",
-	"c0j2goRpTPmeBBrQSQQEDAGSry/LeM7nNGIBYTxJNUmkmLMANps9J+WS9hWDKGgReWqebTYlLiM6pJr4",
-	"NFUQ4K8oKTWvWIUqikRU6XHmCA1HbrWUYawS8NmU+VUrFd5sRS6zilOlyQRubq1epr/Lar8xpddDKdMQ",
-	"K9dmR0xpY7nMAYt1P0iYeiPvX4MVdg8y4B5UWC0LeaiUdGEtpmlANd1E5086Yxz3xKF1RiIXyam2mDHe",
-	"GngQUxa5dw0fERoEEpTK/cYgQGWrPoqQBwL+nf3S90VchhhL3+EwCVXqSsgWV82f1tgWZIu3NzlGzr94",
-	"wWWikomb6TWVErgeJ63ena0gZgXhaTypWmi/YMi4hhlIw3HKpFpHE58jxY2kMCzbKZnHTUIHLkpm1Vix",
-	"v9uQGFUzO4LORhKQTcJDF2EtNI3GLdGFKdUsaDCgvhRKERpFyEeVGR0d97yYcRansTdyMK25QWUXK+Yv",
-	"G7AqadkgLq+5gBlTWqLfPLz4siq251XrYeWU0GD9qwidBdIqEbR73FrCpwK+IiSULNOrJLWtsOICFPDg",
-	"ZxHA3fdcC3IVMj9EfThckTlIkydtBvZFAESFIo0CkwsVcN2BZzjx0aXoW/EJ+AVMJaiwVVVpn4+1WexW",
-	"OVtCcAkRE00Zh4AEqZGHMM40oxGJTJYiQpJEwpyJVOXvbdSgKsIaTdqyPvV9UGqdCnZFpgEWS6Z0sb8a",
-	"JRIpNPgaAiJBiVT6iFUN/4YvCZOgxqyFTcSmYBoFwjhR4AseFLBQlqDsBIcnQyfi3nhfbAkosv1BZ6zx",
-	"bKiDD8b2ZyeoLxILAciAKZVCtcp7CVRiDK/f4Mr+1FWriFExscsT3mGj8qA7kXcKZKfNvsHJWzX7G2Gs",
-	"65SFD8YWA8GRBS5Ap5IroqXpiqdWMysKUyR/z4DIlEYKlwgdgrxiqlKkmPcL9hMhIqB8xynz5i1+g/RR",
-	"R4OT3WRv19BgY6KtDBFqu++Kjb+K9rSl+cX+cm0HV7SitgGuN7xq2+6u1II7e7tt5wYqjWMqF7mxxRyk",
-	"qXeb3Xc+RjD9XtEXo7fIUh16g0Y509dpZ7MJizOzIZ0UORMoorMToCiXSWNTJrnlONkL2IxpR1UlwQc2",
-	"h4BMFmj2nNNKtP2D58OTYywGtQZpSP7vw4fg+mT5w9Y9Z1PMprGXPU+Bn0qmF2+Ma1nj2sz4ItVhU7Mz",
-	"rkE2igJTM+AsBY1HfrQUyId0ODz0cQX+CT96PTtaRuyrJeBQ68RbGpkYnwq3UTHRvfjzvIgka1bjiCYp",
-	"YuHDtM3v5v/iBWsQZSnt94f9odlKkQCnCfNG3mF/2D+0Bg/RCAOa6nCAhSG6n7BuaJwQeZ4H3shON0zO",
-	"/A/lQYTKSOuyL0WwwJQpuDals6n0kiTKBB58VLbbtwG9KdwrQ5RldcNNOsEfbNJG2Q+Gw854V8tXZF7d",
-	"FqO+KaBnEGDpmKJfTNMoQjA66lCWDPGaMrRMOZH9/u7Zv+PGWYRkf2dMDw46Y1rPOg72ryiLoJxNjAzH",
-	"92N3g040Igrk3JRD2cKel2UWGyPGL2ieyjWdKYNTiC+XZq0NtUoiaY04O/YAufugcw1Ytoq97hyuUo23",
-	"hZ7MLAJBNfZ6Xgg0AFuQ/Cb8wrBNVH13cZ6XAByuokVerOdbtpJ2laIG5pka3LgulMyRwZYPASie3wNQ",
-	"YDfEdEh0yFReqkSmHlsQ+MKUVo/4UcOPPOIJxbnARhRRwIO9vCxrA5F8irZrCKlP63aQvFvPDFex+qJl",
-	"vBdSRSYAHKd7plReiFSS7UZ37SeEzW3+qzlYLCEVwT3TD6JYONqCfWHXkrVLkW2D2tFcEC40mYqUI6/O",
-	"kKToxizjCpPtQM2p0Fmljcoxqmik7q7BWQX8VoRrwW8iKFOt4cBrcADXLvaKPtENBKVWc7dI4Ohpvw4U",
-	"nJUsWasY+uS/Jvyp74uUa8IU4eKKUF+zOfSdeLAx8C23SrDnrPvkhYvRvaNAttvkJ+jPSEwjU6lABg5P",
-	"/rGgYEq5bw0Yvn5dZJ0EE5ixZjbhUU9qoGWDvTwgzqzlxis7uBhdezNwjRiyeyMrfEqopDForOzfX3vM",
-	"iPo5BbnIb0qN8gtTK0tsPk8w6V8BlX5IpsJ5LOJmlR1dr2NVuppguEjQksEcyFTixK04SN93HaS3c7Vn",
-	"4+tZx/SLod043C+LUbpJsFaUyx1OWRq3gxzu+KZaM1npA7yggC5k71NNIBJ8hhoW8/Ot5yBuFCtNN0YG",
-	"tVdjv5jZI0ghCbN42gWKlfjZiHstMn4FpAlZHOzlnAf29C14UhlrYoiUB5rvL81GliuMzA22sOMqfHG4",
-	"6F0uey2FxerS4W7riublxnseUWxyWvO8GCd0Pp/IDkKd8wncy8HxPc8nnBFUqzVGpTrDvEcmxiXuHjjO",
-	"kub3gtWvb/54jayefHeA8BBGKjcBJRvW2cQjc/I69BSlw+CaBUsbOBFoaILRKf6+uYhIqA5XKRYPUatA",
-	"sj7X3vs1/Dtn5C36JcQva9gGfnU3IGnn8h1G6vDwtuq+EnLCggD4iCxESkI6N4W6KfBQW8FNNs/PLUOm",
-	"igtTHahe8C70bmELBdcbVio2jAld3SKwdXtLtUImC3J+6qxZnI3GL6AfIaL72kc5q/bHuP624/pOYyP0",
-	"GxzjnJ+SbeKg0yESci8RvAlE/QK6C3xKUgc+rW5PfosQ1X1r2Lxtes83R7aCx+zjvVteG3nsth6x/hHr",
-	"u8P6bfvkkiqrS6/Zt5/58HmlY+N7yvId1TXHsQ+lG7dIevfEZjp2DJtB/nXJmhsKuAAv3+12guj60Oah",
-	"XTF8XfsehPxEeUAEPqa2ri59TfIk/9qj4zRS+fSDIPJkFtpVMsngvapdp+nkovIZzgrNDcJngL6TlNLg",
-	"Wx3el6bzdl39a6DmKZq9jpaPwto63Oq1wVr52FoMnp+WP0coHx7d5huJm1epX7PJ3eoOYiLFlEVQOpX6",
-	"7odYHVwaOD8lR/ddESD3O3R/adkd6onQEP+dcjqD2FjlcmnJy7k7Ak9hDpFIzNrsgqDX81IZZd8KjAaD",
-	"/YOn/WF/2N8fHQ2Hw8F83zMSZeyu22/LWlDoZd99mmSS+dNKuCI2Uddlr07tjxxaTB6IsIfRovgIIXvX",
-	"Zv5tXy7brkSkZrXl5fL/AQAA//9GsJ9GrkcAAA==",
+	"H4sIAAAAAAAC/+y9aXPbOLYA+ldQerfqTr9L2fKa5dVU3ezjnjidid3TM93JdUEkJCGmCA0A2VGn/N9f",
+	"nXMAbiIlSpbsOOGnxCIJHAAHZ1++dkI1nqhEJNZ0nn7tmHAkxhz/+yyKnit1ec6H5oP4z1QYC79OtJoI",
+	"baXAdywf4r+RMKGWEytV0nnaOR8JBk+YVYxHEfxjR4L1lbrcYTAe41qwMbfhSEQs5EZ0ZWJEYqSVVyKe",
+	"dYKO+MLHk1h0nv7RCWNujAxNJ+iYUIokFN2BDHGqT0FHWjEmUGYT0XnaMVbLZNi5CfwPXGs+69zcBB0t",
+	"/jOVWkQwKAL+KX1J9T+L0MJXz6LobCTiASy9dtmwkgsZVa98msj/TAWTkUisHEih2UDpdANKe2Jgrvx6",
+	"O0c9cby/dxR1+0fhcffw8ZPj7pPHj8Lu4KA3ODh+9Hhw3HvcCToDpcfcdp52plMZdYLy8kvL9QBXrfg5",
+	"nENpufOrguVz+NswNWCc9eGrgHHLxspYttfrMZkwqyyPGU8ieBALbixTiegEpf0LteBWVE8EoCLmuJdy",
+	"R/xfWgw6Tzv/z26Gs7sOYXdf4Nv5ZcyhQNCJRCyWzuteajgv7t5L/GTJ5GMViUajncKLN0FnOomW75J7",
+	"aRVof8VPFkJ7sxhPzEQlRszfi1CNx9JaUXEzfhsJOxJ0D3K4ZEfcMjMNQyEiEbFroQUz/ErkULqvVCx4",
+	"AiBkeNN8tQ7iabwEIzY14oDLWNSQhmQ67gsNF6i8Be6rHCHYS4eWiRVDodfCoXRrVwUo+zAH0+GTKqAy",
+	"PN3MHpZoF645yOFWflXpfqfIkcKTHu4SoodAVJL4pSvJX4aboCO0Vhq/5lEkYTN5/L4w6hyTmj+SgRRx",
+	"hES2cFXyaMKueCwj/LlTsTaZROJL9XlPlEG45oeXCZPWMDqC3JH3KtFQGMOHouqWz0rjziN2B7aN8VgL",
+	"Hs2Y+CKNNfMMLOgYy+20Rrr42/n5e0YvlOa7VtM4YiN+JdiIR4wbxpmRyTAWTBO1C9jh/iHyZJ7kPkSu",
+	"5vaXWzWWIfE42vhIRixRdACwUSWuvd+ruK4lPKZjSddVi5XzzGQOOdcTPbYgZgSdK6ENAlAFj3vosY3k",
+	"n5FwLJZdc8PGPBKMD7lMjM0DeLB8PxeIM6eOTkZiwPF6d+hIO0ENW3ICDZOG2A9TSTxj1yORMB7HsAC4",
+	"HTki6UgQ+wsN/BNTGr80izgc+8uEayt5/BMsNZmOYR0pZO5Z51PFNtcw7jnM4FM7Urr6NOhZ/jAKGHHK",
+	"9SU75caIqnMeikTXyCL4qHbYMzWw11wL9ioZykQIHLBigm8Ip6Xp1yD0ydnzd929Hhw2/fcgv+6AGau0",
+	"QKrjHhfge/Lo8d7h4ZODvd5R70nVxDFPhtNKwgqT+6fZXZKGXWtprQD6XZjqVTKMpRlVTZLwcc0E8KT2",
+	"HD+8OjtnfCJZJIwcJlUjT/hQXIRqmthl0ga8aVBhqJhqb+8w6IxlIsdwQSrloMm0D8sT0cVM8Bp0hyfZ",
+	"VgGtSb8qke6FU9SMnj6u3bBf/vuDkHE8Y6ciknxjtJOkm9vTTocIlSRUqctToYfiPVCdavCKYorXbMMR",
+	"T4YiAMKp4bzjmGkxVkAWkdmSTEQfz2mFm6JdMC3vw+9WT8Ud0LKlE94VQVkKyDYIzNJJHx7BqVnSNglQ",
+	"8yk3R5CWnNxNDWmo1/+3Jn3wK6HhrEGoSoY1E9A7jN7xE2lxJcW1Kd4qRZokyXf+ZzbihiXKf1HQe3eO",
+	"cmJDpKawaUFnzL8QyhxV8ivCPrRbqCuhL/rxVI+4qSGo/qmHFL8JGGeTmIdipOJIaBbGUiTWMDNS1+x6",
+	"JGORvctixaMC2J23r/72z+Pkt+f7s8vHk5nq8ejD/7vz6PLFaZR8rtpmgnOq4+XmNnjzV3gxNctEF7zm",
+	"Glo5Fsby8aS04XAl3Ld5sQz4Wxe+qQRxqrVI7MWklopNPAWbGqGBgrlP4hlTBRp2uJ87tko9dyATuuQr",
+	"rAxn9R8CXed9nkQqEVEe8ZstdutC90jwKJZJQ/LMrqUd4V/XSkeGLPhw1+AnI7gOR+xa88lEREBqP057",
+	"vYNwzPUl/o98AgFpVhMtjEgsbRt9KpPhDkunlYb97fz0LRMm5BMRBcyQwdxDzEKesL5gWiSRcCwSPtgp",
+	"7EMZhL9xrWf042726xyk75W1Qs+/B4ZtAOLFiHvGciZCLaxpNZpWo7mdRqPVUAtjLiZCh6Jqvr+pazae",
+	"hqPCjQSe1RciYVrwKCBzlxsBdj2vFDzKMay9Xm8Z7fs+VCxjubZrEHD3HW6rp3Arke7MermIjX6g4c/o",
+	"5ZuA/JL1DtXC1ZRgm5qMeF9YGfKYKR0JvQ3fqTepr8zhA7SaEeQa/+/FMXT0xNxYp882FwDgeNb1varr",
+	"ROitENhVVXk4vVCLsUgAy8SV0DPcxAIlzLZmLdU+RUKHVtneZfAWhLfCOX+qlf6vJHy6QAsgBFuK+jTO",
+	"M3obRDs0Hiz0nywa8LUUcfQCx1joWUGDLM0VBaw/Y26z5la7hlhLw6bGmcZIvTo+a7d9BWx+tCFsXvOO",
+	"wWfseqRw7bn92IofgQ+s0MVJVr0l2SVwKJu/IR4bC3hQdynOpsOhMB7tv1lnzZqCEkrNjITilWTglayO",
+	"L6RFl8lrt4q896Yv+xadZxW3Gl8n913ohggYfQAP2XPZPxf/CpiWBv/+cHIG3Cg0cfezUUm66y/O3nZ/",
+	"PvvlHaBxBHTBj8YmWoXCGKXR7TMCKet3ZYVWOS9OCqGWiDNu9M6n/EamL82dTKZPVx7Prx/emoJhoHgV",
+	"ksh7qOxoOu4nXMaVipa0KDByGmPOABtzXSep//rhrZ/y5/ev3mTzsOPDHpvILyI27FpGhWvY2b3a28Wp",
+	"zO7p7//67fjf589/OTl6c3Zw/vr09e//erP/9pfTX3dx2p3PE1Gpoo5FJKfjVaE62L8tVDRvLVhKy6FM",
+	"eLwUsOkE7DIiYnLMh+sA4mfamVTr8GbM43jV/dk7vu3+4LQ121O69+leeWDTUw0czlXSg7mIru/J29kq",
+	"5K2L8W7039XV0NL1refYeENffZkobWvv6CBl54sgoEEc6y8D4IaoB+GN4nEtAJcyiZZNDwP8Hd5DFVwP",
+	"xVL0SgMwydQL15Uwzio0GnjMQ+TJ48JSxFuCbg4XhorHhXF7+0dLpV4HDO5IutD6bX2nrFgQAx3NqsG0",
+	"4ov1YCbKioA5Ces/U2VFxCYco8d22IlFKy7KKX3BxHhiZxSYhdsLhtcCur9QibGay8RSBHks+8J5hKo8",
+	"BSM+sXV3yz1MYQQ6x5NwhLTVquK07t2DqlmaYBfso8euWIUoVF6IpEYvECTNAWQC6Yr/hGlULhuAvNc7",
+	"OF5m30sBQVNXNSj46PbA7B8uA6aBN2fJLIf7i+/VXEB8NFuA+Z4gCkOmjpo7IJJoNfuANycaGpjhAI2t",
+	"BEhgLmCMZswP3mTRVGcuGlM2GewfLTuaNSyo5VW6IRqus3RSufmDbMMLm7HoHMHcuAYN8zIP2SsL5OAZ",
+	"C1USSiOYTKxW0RRtB5Q5AGILrBwlmWfvTyr9Qouc2EXnNd64gVZjtgfjH6HN11gxQWWwt3O0SSe1mSgZ",
+	"V9HLfOg+7Qf8I3hsWCQs6Joe3kmsCoFIAx4bMR/IXzphtx/1p4gJObWHWAC29GfnGbjKtWW5Xz2w86k3",
+	"z5Gnn7gYYrw9GF21vog7P8drfqWmWlqx3FxSK3e95DKevQf8/+BoQWlHXDh+NitIB93eQXfvsHwJl9OZ",
+	"DMF6S8mqG3HJ3XyltdLzYNdGlT9jo+mYJ10YEOJVGAbaM/9+fntPEoyNZzKZTC2baHUlI7E8P8oPVQlt",
+	"XjStjoXLTFAJE/h6wFLbEmdoVYpUOB2LxAYsNFfuwZ9ywtD1fYUY8+Lsn2wgY2FQYhoqFcGKjXvZP/WS",
+	"ZW5W9iZ9N5Z9zfXMgZEzUAE8aJi66gSddOyifcq9M4cQXsSvzftZYCn/zXMFggi1JBeJX8oL2O/tH3f3",
+	"et29x+e9J08Pek97vd8b88ZIXSdgaIHolXpjCBBp9yLtIewnENiAqSQUeTjBUAayXCzKcO7SG2b3cXQQ",
+	"7g16orvfP+Tdw/A46j4RjwfdR/y4fxQeRgdif7Dr56sCWviLUJ1F4SCpSKEgbq7BECgSVIcpBgIwvh+L",
+	"MZoEQyRjibLpOnKjVoLzZSK1MI2OESaQ1tAOcu1j+qPqjfTxMDvsl3zoldtI/P5STCzgtLgSuhIt9o88",
+	"Whw2R4uFETzlNaXHDdevYtfnEXQVSNbQg9dxDGUXP4W7CZ42cR8Y+WcNz4Mnnud5GtWfWWGq0aHyXh3t",
+	"H+4/fpwDQyb2+LBTI5M2sGr8rPo1Fg2Zk0VzXtIljp68g7HaTcXjqXD5sehsZH0BGE0Jseiv4mmINAZH",
+	"pyIzvQ4ZS4miceas80CmFsybbX9u4mr32JyhDaweqvHYizxvHS/5spc4NPswjVFxnDfqwHJw3tq9rpET",
+	"EIrlshcBS95ejj4dOxK51DmSIgrAQ0DCRdEdnHdE1IgneAEmIpQDGRZFk/RS+oD3bKrx1FjWF6uLKIFb",
+	"f9WuvRGJRou5ee3oXnVio7kY5J6nQD2qumupKTxn5KZQEvZahsUcxBrQaYigPHXlCrwhroJYc8t4qluC",
+	"/Ysh5zNBFm6JM2S2OHg576ODp148LXnm3KO5UyfT4oKEZyJkq6jHZHdzghAPR6tEv64ekoAbtU6krfgy",
+	"ESFM5kPTqqf0T1lCKdwQUKGunRk0HOVXjAdoLOZ9TnhYIByPq3Bvde5XNot2+IZc56takRdvWtmOnI8X",
+	"zlA3b0NmRrFB0ZS811svkLBwalZdcx2ZwjEVgwgDFlJIL8cyDwWTw96KMYVafSacogVXwwZYmYdHGuZx",
+	"EdCqL/y1AYiQDVGAN+LUfHC/RrNhorI1z4Qty3VHKGE+bqQdp4vAahdL9jeFvO9k+sy6i8dasYSCfa4y",
+	"4x4QLprGoglCnvl378S1UVcdYD2StWps4BZdJ0gVKv0nubtecfuq6GjuAOfRabWIvMIJL7TcpRiJ4ilu",
+	"sTSMj+BA1QDkdDjovhhJF+DjYfSEvYqiVyN1Prl5RCxYJRdW8/ASpACcosh+/WtzJ3oyBqXhxJhpBffV",
+	"ghuV1OvPGpJkuGHmUk4mtVodyHtP2XJ5LOhodb3s7vhpvY1GxiJI8xYE7C8IL/DG3g57TXYdDjYb4S03",
+	"9PrJy4IN2A2XvgIKBbA84DaxgLSgyUQmkF1BryVO9He/kwXelPJf9vaXYj2sOPD7XIV/dD7rWYZqbj/q",
+	"j3T7KXKmucRSbU6BefCRy8W3CrEB5pJjMtZVhkpt2O5Cc1WaBhaUjNHqulwiplGVlfzFqapSs0ZmEy2g",
+	"xkqyrTjXdNdy5gzeE0+io/3ucbg36B7yA9F9EvX63cPBkTgK9weH/Ud7TUQ62NqLZuV6HEtEXTI93b6H",
+	"rhTtUcUFca707UazuavDUJ3UQ8ypsooKpsCd9ipLjv8e1U7tSGCzmWMxsExNYYWoOcNBzChk35dtKRKk",
+	"JbG3DoYF4lIZghz5LHorq12UixaXXSBcgH93M9fIqKkOqxmvzoKoyMDpT585y0eG0Jk1fu0IniW2Lgdn",
+	"ztaVO5EydpZQpnhRst2eK71UK6SA/2NBKYF8sRTF+GQSz/IlAwEZfGpLozNLp/vFD1x1dBVvzVuaak1u",
+	"8DV7rwAJtQfWGZwUg4IHGGKsJrP50951UYsVZ60mS3Yo3aC8iBXhGWGZBfwPJgx3go77AaCAyYSxRaEr",
+	"e7PCA2hHKy+8AChAKVFnKC4+F8BXlQWAls8FhkiqnhgwBzvsMiysqgrG3t5x+S6oScetrRJN0ztUFwxj",
+	"hS9+GF4OtZomEfus+mlQOE88j6TiUt4VOBEJ2qymicXqUU4cC5ieJhguQJnc0kJe7SUJcUnGZ4OM0aay",
+	"iRdiPIUm3+M0SdXN1LjpsMTBAAhCk1JJtdQMT+MXEST/fO6o3kpjF1cDSC9r2ZsbS2M9rW9cubBUa22u",
+	"uqKwPOKWLxvnPYdQZE8UEvHFXoRTberkRnpGuo/VUly5YCTxxVJoUsqyIDc6YLyPIqRy7JEbeq1wB8Ts",
+	"5z9PPivZH7+2v5+dmJNxfAl/v/v8PH73+eTLu9/+Yf/9+Z+f38le75eXl/tvzy8P3+2f2t8/n17//vl0",
+	"/92fo/G7z88OT+S1jF6cHJ9cftjrj3+Vg39UnRPS+Iuas4Al4gs5Dpxmdbt1AcZNYNeGuEbG3aaki8U3",
+	"ZBLG00gQS2HSMIz9KBiLloQalfkWQlx1TzPMW5aJthQDfRaVWQ0NS/NuCB3nDP5uiGDJXizzzi/dBeeG",
+	"bboHpfmW1W9cDPxi+/pS0MGO0Rjwwly3A5tCY9cGO1EUANQI7MJc94xr5cDIW9y8Qoxg482oAeDet4Xi",
+	"DG9FiKjoTMNdKMx3z6t38XlrLx6i5K6a34fidLe7x+d8eAvAXVp1I6jzM90SZs3N6JZiV6piWxis8Rrg",
+	"ZRFtQxZbC/fUUC4Izh5zWWNuwEegSHiDuM9eLshpn9UoiZT4X/fLTqjGebMSjV+pPBkDBXLqAtrpaWna",
+	"dNj062VedT9/+kHVFp2qxI7i2foxAfvVZagTOyq8dpB3BK4Wik+DNYoOSBMpCsnBiXLVnstbDX4aUtjc",
+	"K14Zcn9iPkon6IzkcBTL4cg6KDDxpKAJ+TfnznqxHPDN58jcqpGBA531RayS4Zyqv6mk9bvJ41nDUYHz",
+	"rRNZsfqGe/zNbOB3GNbQpi/df/rSWn70FEFX86NXGZA9oUid30jaVvNV59j9vJ9waVm/LEJi6P0FS9sl",
+	"DKQ2i8bE53MGmsqhMDRwsiBxumqg/TpkuagPpc0MMSj4sInQ8wNXYuHKlh6agIdaGYNlzikwbn2bTeEU",
+	"C9uf38AipPkNqcKaphrn7VgZT+Pxou0wsdXZSzmLDuCL1XC4ikP8bvMT16liVJivWMpgQ1u/pazJby9T",
+	"skSoGyROLvXc5QoDmPqr56vz0sgToS8iPltcpLdy04HURXxWiI+rCHt8XJ3oOJfQOA/WSE31YrjSA5kI",
+	"UYhpwtRLghXAhJFqzFg5nr5z0AjQouaDkKYa1gqxozJhGJ+F3xZhxxzSn3kyhdQwq9hLEQrHQRsp/pVq",
+	"ZIXmX7GS+pi8pQsh2HP8CX5obHD5t+C6AcgYnG5WhzDFUEAHHCSgVmiIyvgDyReNrdPzwfsV4IKqJYy9",
+	"MFYLflkjjNA74F4E2EO4teHUyisB18s4N06uQVsZjSs3PbuAh3VizZLLX3nplTvs8sVHnjzipgxb4/Mv",
+	"ZclW7OUyzlCWl6ojsws5THv7vTVqevih4hl6maWxMnQ1MkMeh9MYA4EGSq8ctuoArLuXC2hP+URLjKOa",
+	"5tcS3TnETS/eErZT541PsSLnlXflcZyR55on9sIqD7D7oJPFvgG0vjZ3OTLCvzsnTXwQQwkGFruwLsR9",
+	"mR5J4q7PTcfnhSypual/VqOkunDUwpFjvmzgl0rco7U0tzNBIc+rkRn1gzAiiV6oSNz+zK2CeA8XR52I",
+	"a3YlNKSOkV0iVJFgZoRBhn3BjI8hvx1mVJqOaxZqlRZFN3fNiheW7PTqekXpzoKMtJSC+VmqoV3sdruf",
+	"+h5raqIEikti+3aUUADqjqycFYexqYq2P3zNlbUMiLnTv6My3TRjiXMcbqMlcklbzgr/pt4SfzirWTlL",
+	"da2r81hztaK1CJV2iZM8DUjChpO5INicXFPb5xTWh9S7soPgGTbZmG+MeC361H+jE1Q0HLC5fhz0Ggmi",
+	"Oyz9zjXyhkgxFbvWIAGbaDGQXxiPjcpeUNrX3ybTfNpPJB1aJcIETOwM2cfOiGvNJsp+7IDiFRn2sVCJ",
+	"+GPH5cFM//wTlNpYaG6FYXY2UQZ2Mw1ky83LtWBGjmXM0+jVwtw7eQEytzO0HEAymK0oLabP5vd8cWDE",
+	"GvQYCwqtRY7vp0zS6hRgfr7jO61+vVrJprXIanaId0RVacL57gfbI6xOuM6VU1+BhGbV1M2yXmNmUfHf",
+	"gn713yafxTOm9vV9Ydz/U1tNrnvGzzt/32Ef1HXsypM175eBMy3q3H49UsbhnaOOlcA0jknNtqwKnGZd",
+	"RWCXVtgdIM+z7gRp8Sq7M1eJkdKY/Hk6aKvw4pwP5/kXT2aLuvqCNIe5ndgSGuxZJGa4AlRpcxKeePPT",
+	"OJ9bgcWqeVKi+PTz3DoXBpOhqNGocjFhyNR4B4Tlw+VexWbErTTUXFOYRiXpgvxaKs9JXYrkgxhoAXFq",
+	"NdqjpucXFl6us/HgKwxfYapvuUxE6puRibSSx+ATwwwhNgHhSU2N/27paoogLFhJfaeTEHK9FyyB3nAr",
+	"wCI0GLuDv8IiJlpZStjQgpK0zKLKXLJmmlgOBPAM1FVEqJKsNWsegkKe3nGv0lS58rngqqxy54MWjdKc",
+	"8wQBHlzQz1UTwBOEHyeQxkzLLeQF10IvPeDC+ZSXVgCjsMWVmFAR+lh5x1dNZHF109ZqnggZXqma4CM5",
+	"G9aSneqhaDApFXvKDFa+KPxE6DFPqK1iVvotX3ojy3zE0m75eNNcnbc1/JxOH8ptXNWBfd/N0tv2AW37",
+	"gK23D2haj5au2sIi/PdbVL+0kAWF72kpbeH7tvD9j1b4njDfeV7fu2JBtZfgTtswf3O9UdftqlK76W2V",
+	"+odcpZ5Osa1SX9oVI/RGbd/UXnKdSpbLXPObDsPABxfk16+qS/NB2KlODLN6Kpgc0MoIFGmY/47KPsQG",
+	"X1GA3NeymFIP31e5+rYYBrJeV9AtWJ23FZFSWSp6WfBIwbpdOv2qu/HPtApxTY1jLN+xOGnWVxym6ivl",
+	"usaNw+RylZYrUzmb9mQw0/EYokvdZiuIA4vjiiLLvkVDxC1Py+0htuhcbNUK9ZDdeiv3GQ5h9goOZCOB",
+	"O32R3s6NEIp86M9FmNq2y3AcdyM5lLYiUkiLUMirXHlRN1MG2t7+k97xEdW9sULDkP/38WP09fjmvxqn",
+	"mM6DWbXZVQG3K6WaHi4KndxA3GMF1CBRiBBY3QzKaI4JSDIvPptWhWC/Srz6VLDtFpty/DeNwD5Oe72D",
+	"EN/A/4r/dsU/x0ixS1bMkbWTzg3AJJNBTT32tL66v/+EDHB90hrW0uYEBf9Brt/x087eTm+nRxWnRMIn",
+	"svO0c7DT2zlw5ZFwE7BO1S5a1+HPiaLLk5Z4Ook6TykFGzj933gSUbCE8608d1JrqBLrZHasCUUA7352",
+	"lTuJDC0jUoVM75viYQMTxB9I1EDY93u9jc1d9AHg5MVjgeW7zByUfKeIF4NpHCMJPdwgLI5Oz8NQ0/cG",
+	"p9/b/vS/JmQvlX+6Sff3NzZpmVdWTP+aimNlPBBgOLqbfQeayuO0Kqh7Meg4fkh3BPCCewGEvLF/dJC+",
+	"fIJ36aoV2F/tjaMAZKG3f+mqQp0b3b3NIVxBh6i7etrtiIiKdy/oUNFdhOqtM9/U9QY6SQ134jqepcU3",
+	"3ZFl0OZK2cEzs7uyNKtlBd+9+RYIxZM7IBSow1HYlzRewHJFRbG0qWnpR4l++BvPODpXl1IRI5Ko64XJ",
+	"OiLi49m3TULKcfNbYN61XeTy1rDqQPvURIiF7KxiMzXVrFkQfX3PuPlj/ufczHlKxfDM7DchLBw2mD7d",
+	"19xu5242XeoKlQirJg/UlBwCG6MkfgFu4sIkzYha5YJeFZQ/T6NS9e/2K3hVIH7ZwKXLDzfILW0OgRfQ",
+	"AXx31k2122pCkFOQt0sJKjTx+yEFr3I7WZIYdti/4frzED3E1DUEglmsvBI7lfRg6cWn2QqX3U+9w55V",
+	"TXTnVMCdNvsLRF2PeQySinDE4acHSxRcy4vvijDcv1xESIIMDHbT2aXMTyWiRZc9b9Z2u1VNr9Jo2coQ",
+	"gbPphLpEQlibhB957JHW7LAz4fqVvDrnQ0oEzQUCEi1hMmEng+47lYjuKcW2Ko3tK99yY7unKiJiQK/5",
+	"P7tnMglFQMEHaGbDos7orD/oHbJ3yrL0U/RSJMpiwgH2OMT0jgjoRslk4srXZvR2wjUfC4uayh9fOzLB",
+	"imMC6+qTfZv+ySPW8tgYgNtlaGDCxolFi9XEQmZx3z8ys8TyL0Faw/pjxwcrjDQ3wkCWBYxISZia9YW9",
+	"FiJh9lr5vAqX4MGEtFm0yZiSM7r4HbYtwPrAjONXOwUT5ccOzDBS/b6EZI/ucCqjSst89d7QSi7GKipu",
+	"0cIaklk2TO24GGS1eM8XR1sVT4D9ZTSbjERicGPMhIfCNQYYJkqL6KcVVuyr+DeVwkuO6JpRwQ3oaofU",
+	"rPmXJJ4VK2G7jnuFxIZUvTt52SR7AMjsJEZ9xbliq6BztTZXhcxytMs5mIRxIe9/CdV4zJkRcPcsbX7z",
+	"8H5jZ4i4sLRO3XZaPlwNJ314e/0BUSeURQiZ9iCAd3Pb0J8FTCVIH2CwwMU3BizzXAUsS8sAhCaH9w57",
+	"T5lcWaF/VwWcsDmdSybovnal7rFhAyVydbMpdliWAQEBUJgd1hc4APkwtIjFFceWsaXsLALbVdjAlyz5",
+	"MQMXnSqpiZ3LDCCCTF/DMgoEp1vw1zW8dK521aLdz9UmK5SMdz0gFofp1M9KxbEWT+0CKOaqe+XByJUS",
+	"WwcUVyd/CRfKKuqn8RaeHU+wm2C5lj6hg5bDkXXRxhKwCftEukhMrEY/y29vGkPnGvGEatzH5ADSCOFF",
+	"oLLSUJDdWHDS7/uC4tWzzEPgmgMZA/fFTwiZfTkw8NO7jMd7q+Ffy5/yJfdLx+KyYyhAoCZQRlH/VNyG",
+	"YtH/HfYCnsDf+ThF30dtQUsApT0vyGrrBVn4eOK2lPoxpvUAmS/3y/pTS9PZIi7tdObjHG4+bdHrM9fe",
+	"okI8PivacAinI0wyyqWaZeVR81EIOdP0Cx6ORPeFSqxWcQ1nm2h5xa1gIcdM2jGfUSS3C3ciGEnkwmZN",
+	"mPKkhZPcBSC0awk9NaLGoO3mCFiiujhPpcoLYnZlOADE9atBAaCaiT52nvQHR/1wv8/F8eHjwyf8Y6dy",
+	"roJ0Xh8nlBdEslAPlFulRn4f+ALrgKCu3DgFiDgxvQbS11oGrLcPpbMYdH9nvYOnvcOnvSP25vS8xox/",
+	"0DucBxV0hbFbSFDYI8+3VCIyqoS6TI3SgtpFolLQmQE1ZV5xoWtaGIEsDev4B6u1+5zX7ylYMzJ3+FhS",
+	"fhNATHaGTWj3uflIE32n3Hx+RUxp94s0fuZdSu2Jfiq4+1HVyjv6//h08ymvxH7wTKrRffZqLTrdO59u",
+	"ghqD2wuUPIr63zbsbdk89+S6W0Y84XnqZtu4387lOlT67fAsd4/u2G9XeYNKNrinOfsbfMcw9Pf2F6fS",
+	"1HeaToU9vWCqn344gnArQyCicE7bPXv+rsKXecs15idx4ipZenTdhPfvPF2FzBKhcr5Nd23LxDQ1Eu72",
+	"fRtBT11LgbXThLgp0QGv0qJcRPmC+W6DMkGuazVPDMf4+IDiuFABjoTG7/AX4X5QA6pWiKYAkre0IPOk",
+	"Y+qge8JbV0LPssl22EnCuFVjGYIc4JoCOzk9SA2H0jDDr7yIzZNsAGwAZ7yAl18FFS/GYHDMcUdaKiLh",
+	"ISO8QdsPO9w/REgmXGPudAZKeUA3ChVMQZBg6lQ0d+3ocl/Bi75x6A57KahFbJYEOp+rusMocN7kjodE",
+	"W3d58dWKAmK5fqRjHgnf/M/rGbRThUXlLpDbiN6TUj5tzmZLEtW85fY5IN/2WXc6zT0FvOXmr2ffaMiB",
+	"FylvUSug9V62BeMaZQI7e5SIY+wkR1UZqNSC07ERuTotG33obPQBMp0q9pA2R+JABYZxWitkIVsKpSUy",
+	"WOvFeiMSX58KLoh/Hx1WriiJ1zHofxTo75N8wGiSlfOpslkFBaCfy/65+FfAPpycwdG9OHvbRfSMVDgd",
+	"i8TusBcOBHYpZoaZEddkgTUCI/dzlNuqOGIcWAY851GUVkbPZ0mWOtZbRXrT1OasQalryHubRkL3hbZ7",
+	"T46PomlCB1H67WhwwJ+Ee2KH/V3MCCDkQi7hBAg7LtyvDBP9iBHk4AHv4VgYB0ckJgJLZKRtpgtbWe+z",
+	"85u2ku/OqRBNr6mf4zV9Vmv+a12CrUuwdQm2LsHWJbh9l+BqnoarJNpJOTzghdkJTfw/80JQGb2yXKq5",
+	"kmn58b90+7JvxZeiXet/YRO/lrhn8DFh7mDZX9nXv9HTgL3WPLm8waeYLgQPX04TQT9lpUP+yr6+GMnY",
+	"qoSh1EPPsXDJX9lXmIV+AcIIvzx59Lh3eLi392j/0d4TeHTzsUgw5656aWVa0AF1ZTJQXTMzfovqh1js",
+	"lxk6wSsqCl6tiP6Ni+gf8oS7IC9nJCz1WnKWVmqtF9IN1UjMiehFIc/VUFw5Nus/nbJ2vpggY5kYQOLI",
+	"dbfZYbmKlwzOh0vs8s+T2fVIaDTRKOME7tTrKi0KtZ7kDVQcq+ssFTSMlUkLOLrKtimxgOKNVHbhrUiG",
+	"dpSVvUj/bix6xHIsbbX/+ShoFKpgcsv3FjbXZ869DGmfS6IWtukNrqpI2twhjEwrG6ElPd846XGnTQI7",
+	"ILkJ0oqyaIAFcTGrLu0LevtCN3Y2cZWZaknRVxnd0EWJha1I+j5VV8IUlIbUagqyjdDo0Qd5qY9GZyrq",
+	"pnRVWT42TayM4X1pGJb9i5iDOFdxSQs4GakSGEIqiE6nuQt1+6RJbbw5IzfqeCKJJkomFcoz2YKXk1XI",
+	"PM6pWtGKZHVxj78tNFRI6SG5KzPQwRUP2LFEMPcBy4WOuq4qPFZ3zNm2SUx2D6VhWnymsqFLjNhpSAgj",
+	"IOlb2tTsW5d8JpH5DORwqslU795k0pa184PKiI1P20/fQHdYZd3LzWVr1c3xIzpHD9Zd7mul+zKKRPIU",
+	"kurINZUoJFCSmh1alZZQGEmTFsDdwNLTudN110wr0llv6wueJlgFxSpvU7Yj39uBRVN8wBMmIhTakkEs",
+	"QxuwSSy4Aeya0T3fRO5IfgZavlewU8pwPZKx8P6XvgDMm8d22pC9/XU3JLf+NMvSR0C5kKUsypIEpXRD",
+	"/C8kA0cb3aD3WqSJJN6NWdymOSJaDslC4u7kmse32R8/lifOGLXXzyUuFQPCUlYNu7rprUi5Am5GClme",
+	"X4Ax35GqFWOpSARgPK1akuu7WxFPBSrMycsKCSr4MVKE3gj7HYpL21TQGkWbmUr17PsLxqViqlbaGbOE",
+	"8EXAULBUg9rwCgCcVwb0ossI1uta0+UJIgaUj9LiqDDSTm0A8EF3SyHAAd5Z39UB/u8jf9tw31aA/f4F",
+	"2MPbBzOevGRNKP5GE7dx9tyAqwgXb4TdhGQx8aGFRche4LUzzKjMl45OL1MsrB8LfuUDI9AiYhg3RP+5",
+	"Ft7NFc0AU51bnFPI0KnQQ8He4439y4fXL9ijgyfHP3k7TzKNQZYZo0WI09RIGtzXue+On/T2f2J44qLQ",
+	"PQ4gkT6YxJNuvyukrqTmG0aJbBY9bL6Gv2ddEYvlpQAwACr6dF58eT8fJ9fae75be0/TAMguItX/zBGh",
+	"P8AP03nasRRkhVjxtLPrwluueDytau5wE7jPtJjEPBS5L3NNHtLv9/aObz7lj2cRlYJ7hTg8558cw1Wt",
+	"W8hX3/cDrmyhPUO500JjegmXCMmDB+duA0GbCNWeVNSncKwipBItJe996Civc3YZL6vWC5WVSNqmaLRC",
+	"XCvE3YcQ9+0n17wq2mM5s3D3srQBR5VyghZZDEkAS8jz1V89Q6c1qy42q+4d3WZ/XtB33fPZRKQ21UVs",
+	"HE9zgbiyCTJoyEYpIjYWkeTU4y63Q6QZJPWqQVKS+VdwrOd2Kissj5pEFkecbWHZCl2oA7+geOB2y3G2",
+	"pvZm2vB7Su2KZ04924hqPK0Il8oa7LVqXqvmrVPcea5D47eo37gI6Fa/afWbVr9p9ZsHrd+0SstWY0Fa",
+	"UbwVxfOi+K8bEsCLccNpsvGKucbz7RqlNWnSF+VSpTbr7L8RZvsEjiokUdrkGROOa/KMnVybSzFm0km+",
+	"3oXGxzKeFTJUKTfXA2RHogRDKvPSi5R+Sp8S9DVZxbXxNc2yeR+s/rLhNOQ2B+47y4FrJe1W0n44kvbD",
+	"T16kyNOlJa6Ix0P/TFiHM72V+8eiz9vxd3i1wNxT+enn96/eBOz9uzdwMX4T/fdMjvlQ+Jwe0LeJUztL",
+	"0aUQExqU3gOGO0yFCQysG03H/SQtRdWPpxrjBBGekYoxxZvMG9So3liRltBCSC+m2n1Mf6ZD5FZQEVIy",
+	"JY4Nn7Tmxh/Y3IiIuft5IobF+57ucl8mXM8qhg3ct5Nk7U+vRX+y6rfflEUT7w+bTmLFo2/Dpnm4DZsm",
+	"kUTUK1198JjrIbZt5Qnb650+35ZF8zylndLMTdraNluJ64ewbT68ZLhMkAKe6QlkmwN3T8EaGZdHiub5",
+	"dvYHcuI7DMtQlvE6cfqnb6VjaWuXbWqXheudu/PEsdWgbKpdrqeNpLFKz2pNsVA40LOJK2nKJWzIzOkq",
+	"WIXC16+C4gv+fVoz7LGIXMGFQsITPHe1q9JyXUFFPi/gbFopwpl/g+xIXUoD2qaXty6jVf8YdtMfp+/P",
+	"3fRTIbReGnfhblbGGSsa67eSdCtJt7bLbdouUUb3V/EWDHL3q+dnJ9HNruND9XX8z4Tjmugf9ManvuzH",
+	"Ug01n4xkWJGEx/o89MWSpGaY+mN8rSM/f642ZMpWuXH9B/xLZCjTVCTV14wHMCZaDantqy93P5fk5zOq",
+	"vfgEk4dTrYG7V2RbB5QteC2NWGCBy/QEHqX74S0PVPC+osT9DnuWzIl1EoYW4aWISJ8wdk6myxd+wtCQ",
+	"az6jssp5M6E0Xt6YlxQ+0AnnKf53Ki0UAc+wfGML8EMWFvGotT0/pFDXiuvwLce8pnqCVQUUbINg2yDY",
+	"Vry9b/HWU5BUxH101yJuCsH3ZjBeYv9MxbdSHO2Im0xWyofRtvbjTcXNtpbTb8Fy6uSY+ZBW5VsJ+IpL",
+	"aOpcrh4mygpTW5EbjEbvlBU/hqExLWHevKEFbh+7xuLg6Ktwxb5dXwvsxtIcAFdyuxk2wrn8XSZR/XCt",
+	"4XRThlPY6zXrjhOGFARvmaSWDezJ0QqbrbDZ2lK3b0tNr2K9ERUu+vLu0d8hS/y0zS7YRD7vpQv2MsoN",
+	"z7fXBRsw7vZdsElI233cNsxuDTUt72x55111YQHs4EjDAoYdKAE1R3I4iuVwZFPn1XJuWqVz7n6Ff07K",
+	"bViqOpd8xxpoEXDakY0B77hPBvzjb6OOeIMuI8iWff+cElveXJ+R+llartJyladfbwsccgCl8SKuW1x5",
+	"vnMDjpamss0lrWcqTKVJ742wLT39vujprfWfmr4MLRFsieC3QQRLFeYbU8AFdfNaIvgNEsFt1bhb2QB1",
+	"xwS4ssZda6hpuUnLTRpyk6bRL5ut5+YJIgVb+sCQ/iyNTtGe6Dw048986ayGTLdk6/Hx2rWlNOY77fpI",
+	"8HLs967SLmTDFGK60YdNrXdDkVj4Cz7e+ZjkKmtgxLVLr8rFaFvNE0NxG9Iw7hp48ySCF8YTw7Djt4gu",
+	"OAXxDGSC9bAuuN3BokJVosUHAv29W3pbLqMNWV5bcinh0jcatOygzLIz2qK9rXTVSletG+wWhS02Kam1",
+	"YcBbLJ/7R1Y/l6SqQgXdkCdZ80zKe/dCFKJ7nyeRSkTUufmU/wzATEkB1dcdlCVaEuq2W26X9vNsTlqE",
+	"NTlxsW1EvbpeYZdkd66T6er6zC4MZvYJYOK6rZ3QhgCvEgJMWLNmELBDzaI8m4hrYVzBkVZ+a+W3Noxp",
+	"uyHAPI7zF7Gev9BVXx4G/F0ykq0GAnsiei+hwMspOL2xvXBgwr66gGB6anYftXG+rYGjZZAtg7wzBolU",
+	"rwk/LGlcRhhKM12kcjnb8Bm926pdrdq1mtqVx5611S9naXD42uphLZtp2cx9pmJW3chFDChPBJooZt8x",
+	"y9myglYkt/ekqDWl+R+KaMRiNRxuUnFz45WwdQMZnR7vd5+0yl6r7LVcuOXCd8SF36oh42WCtir3rVED",
+	"d7+6/zXK6vwh9MIi4On2bLA2aZkzZUt58mCyPctsfOuJn40mbJlQy4Q2F7BeJrkbSwetpOWVIdpLCTm9",
+	"+LVGt3oWRbCYcz40rUrVBCdyG/aNxu0CaIxHUVZpGBFnvTSkb087eDAk9bsngA9YYn4WRRCLjubxeiEZ",
+	"blI1Qd39avlwoTz8QYzVlXCk4ocQhC0frgh5vk89ZAagrwK2jf0l5EZ0ZWIExmZeiZ8K6zChFEkougMZ",
+	"WpKQ70HAhZhNAte1fpons5sUb+emW0DZWzr6IOlowHIXl0VKUI88lLvdHVnVPI+3icOXhDSNiB32bzO7",
+	"Xy/F7Gb360DG4qa2F9sZJFwZ1+0sFqa+O3Uqw1IG2KVwvW/wFex7kgyncCGgaDm2svZZQSORiCuhswEp",
+	"bhnbY0cBMyo3fSKwRB8D7ASCSOeF49EgNOp8c5U3wq7cCPtSzFYkeW7V6UoKZO3093/9dvzv8+e/nBy9",
+	"OTs4f336+vd/vdl/+8vprwiNtULDOP/3x7Pu7/vdR5++7h/f/Fdj+gz7syK0SsuhTHhMvfwQPXMVqbO2",
+	"5bCKBLzJf3T8JzvYTTrI/p4khT+x12TQMWMep++ORSSnY/8XNvqlPz7ld6n41uqE/yE2u54/Ghyu6Ad5",
+	"wcOR6ELrT63i6gMFHCjcA4PXR1o25jPWFyyEMSKUIDibCa5r/CITLa+4FQHEHXT5UPz1YO/o4LjX6wVM",
+	"jsdTC5e41qdxeLucHVQlRJSSaxaqaRz5ptSbMgojMShr9IXiJVlL/0w9J/K04J5U5TuILxOl7eKIm1f4",
+	"TkacthqgQZOtEZgB4bhuNZ7MTY3QNR05fzhr3NohzhV7msMlOq6iC710XJbD59daWtgWnrgR/YCx7Guu",
+	"Z55rQ8u3oSaRBP52L4c8oRvmU+rpDvz64a3/0LtiXcrTDsOJi1NS4KxhnH1WfU9+JPz9/pezc6a5Hfn+",
+	"7py9eXWeJgnHEs4pIBpFuUWh5tex0AbJlxGJ677GMLOferruwBgolZjcWuB0jIWKAnOCADnN5+/bttzz",
+	"/rKtYEzanKq9/KafZ+cPyZAgLk+sq6cgDesLOF1ALCuS1fzybz320fB1Hnh6anYfRwfh3qAnuvv9Q949",
+	"DI+j7hPxeNB9xI/7R+FhdCD2B60b/Xv0YDysCrtA8WopbCXVzskAaGKqFQTeiDk54E4NSulFzVUKa3Yr",
+	"77Nc4trSTG7Jrd+w9RvWLfeV444ufKXJjdho+Iqbf03nYyGE06Vtq0FGwBpRrN1IXSfQ/7/WTvTSvZCZ",
+	"irzQgKW1GCyY/PU0LnTrR65Hf5KACDclFixS4XQsEstGKkY3KYI+EvGVEwu9DS3r3ys12vsD/1qQZg3C",
+	"+4mywsCML87+WZjwTzlh0D9KXqE2h2PuhOYqHYf+cGPBH7nxQnMFY75RKgJ/rimMDDPRLjgIWaji6dhH",
+	"zWYfedGcPvbCcPac1isNe/b+BCV0Ocb9m5Nr/Qm0HKSSgzSx4eRH+FNOVh/Aii92NzRXGzEBIfqURei8",
+	"PYiW3n0pzURRiYvlfhgc1CpmvNHZIS03NfI5t5aHI7iO/x9+DIP99WMHr0p3v7d/3N3rdfce78COL6ja",
+	"1PLWlrc+CN56q5asuQslybkDRHwGm9cHvkYUWkQb6rrqphpxmivlsSuGJzmwMvrQSD4YKh4vtmm+UTxe",
+	"zIdKyXBzBullrRwRBiJt0nh7dnoy+739o3tOhYMtWFMzya3NWQRb08ADzJaaO8a8zJpWWs0uGWDM8myp",
+	"4tXanvGS8PdeMoqWXR14vr2yD3BsdRZLeGZ2eZsF1JovbycuIArzmGQE8UUaazawqGeIvB6tsb06NCku",
+	"TZSqbMg1H54ZFrtL5Ils0aldJqepxJJaYBdnuiyXXbamQzvSk1PBHkxSCCL01jNB6mdpVc1W1VxIbL2i",
+	"ye86BxFn31j+CNK7cqpIJjnWOZdaorZ5CbTtw9VSoh+GEpV6eg3ThUhrskLA9YRpQW+v75U2bavJxMqa",
+	"+R3TxbY9VkvkWyL/rRD5+2u1RTziO221RVk7eihsqRzHQgEdbBHkxTe7IYcAAJHP4C7d2kkWVjEWlkfc",
+	"8p2oT44aPF0fp6uVcmC8oEF9bAEl5OAH0lADBhExTQ3hr/msFFPBNS7MRxqIqC5smJ6vEzYM8BAxMgGb",
+	"TPuxNCOhAxbzZDjFVJSMTZuAUjkBSJAaEmylIHg4QngRXA8pNZHCZ0ZoKQzri1CNMRoZIjoGO+w5rdHZ",
+	"whyAbqMg3cFiggQc3dnzd0APQd4IcJax0EN6NpBxDIDQ51KnBDsSFhIBAsYjH7mShqZkP41d5rbUhbCW",
+	"7AW/0PmeWYly0ShsJiqCQE5wI9z5N3EQjKexlROu7S6wty5g1yKrDyBRTYHKMm46+2MJFwNAD8wU2Oud",
+	"Pu8EDYIjioYihKDaSnR3YdW0zcvCqin+pzao2iPtmlHV9Hmdj8LTl8e8J55ER/vd43Bv0D3kB6L7JOr1",
+	"u4eDI3EU7g8O+4/2Nu+WwJVjPxSXtYPJXi7qHw9+S2LaO0W7Pp2Qo91nXHriZ5UiWNpA6/tgsUHnaP0t",
+	"J5SGnZ3jcD7awkwnjmX1ZyREUsPADcVb0GB4pftTGVPcippaFg6Vj9zzLol5GBMhIrOamEF0Zn6snFBB",
+	"r5TFiqGPH2wmWKTRihTz4VPQT2eOXaoki0j89qSJO2fp2+bf6V7fKQdPcebC7d2FC9WFsFf4rOXdLe9u",
+	"eXcbCbUC36qLc1/KwCBiIW6oFp/O2ItYTiYyGZod+8XmdWPHDXxKgQHGElEBfc7+jpM0Zmihn+QumNor",
+	"UGJHcjiKAZCAWnkDJMBKxlxf5lRb3+ZbWoOPczZ5VKZjlSuTAbNZOU7bX1IpMUVg5Hck4985FupYZ4HF",
+	"Y4NueC9RtsR+gcvhIx9XxT0FxXbf2bmlw6V8HLglfGku5WSS1gFxO4en5XY1PQOXG2xgZUOtrk0df6VV",
+	"3ilzrcZRxw4Inpa9tuy1Za8te23MXos8KcfRlnLXZVnI9NU9uWPT25vLIWt2Ve8zWGQ53VsQ6++W3PoT",
+	"W39irfN7XMyUanAjNupRdPNvPAs5ve3VFMs3WGwWtXuv3T3TTpEZ3Xr0gMq5A/B3UcW9fp6W/LXkr265",
+	"Dm88+Xt01wEVbv4NVoDH8VCh7YtYJVSsOE0Sm68Dn/U3rpPaWuq3vf7CbZhvS7J+KJJVCvW9Db1aEPX7",
+	"PZOsbUX+rtGQ/R4IZhv/21L/lvp/S9T//mKAHfP4XqOAN8ImwdjhgkkWFlU5gzDWO6oTjXOtWSbaraV2",
+	"K9ri0E2LQy/dyQydzujVpeVD5pBoW/VDHAbdSwGR5dgLL2yvhAgGnNc5Id2h7h63VURawep2VUQIjTde",
+	"RoSGTctXYh0RrCQ4P9PD4tpEmRiH65pe0nkKmmPIFd6HUvVT+J2ifnFA18nJpJVLB0wl8SzIBc2ohEkK",
+	"jbkUk6pynjhViVDfqXrstyajWscPxp1ByLt1b8aCaVrdsNUNb69MEYJtzOtApKixdpIXJ+ucDi2F2o5Y",
+	"2jocWrKyliDoLU7Hd21xuhWtKrkbbkGoFngbvl9atS1fw+r6+50TytbR0FL9lup/O1T/ftwMSqfuBPO9",
+	"Gi7m3Q1rs8mycYMaoNd3Aaa8agMbHmGnCR57smV22Jlw+UCvoIs1BqJPwBOipni5kIAzmbCTQfedSkT3",
+	"FPKJ8MykNewtN7Z76v1C9Jr/s3smk1AE1Do8FNCnhSdMjCd2xg56h+ydsiz9FLNtAQmAoo24cT1QK3oA",
+	"ps4NSB3+3gSCoLq4/oQayi4CFl5hyXTcF5p23HkhIBm8s6SS/oJZL4z8c9nUY/4FxvazYzKbGJsCGBOh",
+	"mVvFOqCEU22UXgJHIr7YC3rTW/VTVKZkujxEmTnPpQsOrNDY75K6T8IQEz4EfOzP8tu7w04oK9AlAYVq",
+	"3JeJiIhs4YsuIQrshmwseGJdF4mp8a+l1G0gY0Ba/MRg8wlK7dPCTnVC/X53CqgmZj//efJZyf74tf39",
+	"7MScjONL+Pvd5+fxu88nX9799g/778///PxO9nq/vLzcf3t+efhu/9T+/vn0+vfPp/vv/hyN331+dngi",
+	"r2X04uT45PLDXn/8qxz8ozlCyiSMp5G4sMqWaq1HYsCnsfX3q3hKv40EknmrWKimCaXBYIYibjO2cGIv",
+	"4An8DXuMW9hPMwmJTrhjwY3kjE7cdcB2aVHaWHfkVNkBEz5pSzGPH0egvEpf/Ib1p5ams0Vc2sm2pa9U",
+	"LHhyB80ngLit6b1NbdR5StW8FzV26nA9pH0/V2zhapX27REIrjSXdEZJcVo47oj5qX0xgA+mRizrU52o",
+	"Ls5ThX4dYEvzQD4DHjHyt9wDVDPRx86T/uCoH+73uTg+fHz4hFc2Owo6BW5WTWMw+zbnCSg1Uk4LRvkW",
+	"ZiorMxFzYz1bq4H0tZYB6+2zn3nCoD8T6x087R0+7R2xN6fnNb7Dg97hPKjAW324RlDYI0wXHAmmklx/",
+	"M+T9NUw+a1FDoDMDbH2e0dPVLIzgS7t4zaHVhVpd6L4N64XwjJRUluXyleIynkXRdyuQbslCld+yLZqo",
+	"Gngbn2PdAqyjYJVTyrbmcyxOlh7cmvavb8+E9WAI7t2RRzpgd02B3tw1nVQapy2ZeR6U8eRZFDFOq7Cq",
+	"Ga2uNpLsfoV/Thano37A+I/v3sRQhJz2ZWPQw3AF4I8eTPgJ0miKAXKl9O6CKRQmXMQYWjL8MKXUIM8D",
+	"vGJUUNJXFGMBXzxVRKxZjy6G0uKy6w3Ib0QC5NFFxKXvo604jothcelqAjRm5etOySTrEv5c9s/FvwL2",
+	"4eQMtuXF2dsuihu+1NcCq+8LN/8PYvl1nzVV6PzuvKbPViWYV0m0kx6wncXYKT3+n/kbgQZe+E+JuqXr",
+	"4lrz2Vxj7i/dvuxb8aVIcf8X0OfrSOi+0HbvyfFRNE1E8DFhrs44+yv7+jd6GrDXmieXN/jUShsLePhy",
+	"mgj6Ka1HDj+/GMnYqoRqjNJz6DAHj2AW+kWafgK/PHn0uHd4uLf3aP/R3hN4dPMxKWz74pbjX7paGAH9",
+	"6LsyGaiumRm/RfVDLLbqDd29i4r3rjWitEaUh+RQfrhtgAusrsDm+BIOS78uyAQ758M7ygM758M1/Qiw",
+	"iDYF7NYpYLiNVT2kc/5SQqwprhs7YFg+zCHXOR+mmAUQ7mox0MKM8iVWyzosvnAOb283VwyncNPdU7CZ",
+	"A6Eeyd+J65TY4gn/hScRUxMKhkDER/jp6U9MGjP13orNBaK5SS5SJEt3aFvhaO4mFVe30Zv7IT907uLA",
+	"ZXJ3Zyu3d27e4kXN3UR6L3/6uYuF19ddLM3NKEezixC8xbbLcGl9wki1/49qwGoRisTGs/RldEhXKzXn",
+	"MO9iZeaHDQbZtpMd934R4aDuBK4sMmJIjkf+6ClDD1ew688fa+4i5xkvPMsTiGbZhKJQElv5UBVpjY8L",
+	"oJrgJkhbjhiBagHFAVEIAUWu5AKNpkmkElGXboiwiugeTdYP3egL2hlPCpR784beqklajb7V6OuW+zzt",
+	"CXDykh3dtUL/vOQ5XIXkvp/DdG+uzlPepdR2VwsMPavvJ3Gapmvj6GpqPTnHUVYlwbmehc5SnvVUyJNj",
+	"BxfEJMpYpCHsBENO3xtxUwpmVxrbGc1T8g80ZEvK1xEiloVMOj+bO7Q24bslwA+QAN+q2sjzfI+WjRUb",
+	"eZYnfLXEblVxHa9pwb+5jGUAsTW7Y7FrLLdmUYuEX43QZ/DSSno3+I6WKL/wCga38zicxr7LMPwaz7B0",
+	"ujRWhgbIbMBcpHxqVg2nWovEMjdPeoL7vf2je1SUPxB7xP1aXDvSsdFsma2u/PB1ZT1/rE64gW0RiYVV",
+	"iKisOcMVO+UJH4oxrDh/Q5c1MIFPay9mrRBz8rIgdOXMTQU55rCZHLO6dHWfkg/s2KKrCc8BWbFdUHsp",
+	"RXRbmeDX1I9z8pId3rVMgLPfolbDNI8O5TzT+YuLw0PzXLqBUx13nnZG1k7M010Kq+wOx0O9oxItEuh/",
+	"F6rx7tVe5+ZTOurXqhVoMZTG0t0PWKyG0jW5I7TJYEivIC7pJiiP9ounIMBxYoqYUGTky75FU27jj/Nb",
+	"lBuktDlNR3NqZIDDdiMxwHTBUMWxCFMHcwle71BuOsdAC9EFIuTiZPkwNxg6EJuORP1vSQG+1tJakXid",
+	"uQJOXwW16ehO6f7PFP7FOdJGiYYZfuV7ARpbnukdfNF4ngLvIs0ecKxyBSTg0EvNZ3BinZ9kqHicG/UN",
+	"/jk/luvFgzCQXEvSc4725Eaht6vGefUl7SPm+zVibDRgbPb9qy9137/M+7ECLGTHpomVMSX0cZ0ZOIIq",
+	"oyWQ18lUUy6bRzOUxm8+3fz/AwDGQfshZj0CAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"database/sql"
+	"github.com/hayohtee/books/internal/blob"
 	"github.com/hayohtee/books/internal/cache"
 	"github.com/hayohtee/books/internal/data"
 	"github.com/hayohtee/books/internal/mailer"
@@ -12,10 +14,15 @@ import (
 type application struct {
 	cfg     config
 	logger  *slog.Logger
+	db      *sql.DB
 	queries *data.Queries
 	mailer  *mailer.Mailer
 	wg      sync.WaitGroup
 	cache   *cache.Cache
+	blobs   blob.Store
+	// stop is closed when the server shuts down, to end the background jobs
+	// that run until then.
+	stop chan struct{}
 }
 
 // config struct holds the configuration settings for the application.
@@ -39,4 +46,29 @@ type config struct {
 	}
 	// the dsn for redis.
 	redisDSN string
+	// the directory the files of the exports are written to.
+	exportDir string
+	// the configuration settings for the exports.
+	export struct {
+		// how long the finished exports and their files are kept, zero keeps them forever.
+		retention time.Duration
+		// how often the exports past the retention period are purged.
+		purgeInterval time.Duration
+	}
+	// the configuration settings for the blob store keeping the book covers.
+	blob struct {
+		// the kind of store, only "file" is supported.
+		store string
+		// the directory of the file store.
+		dir string
+	}
+	// whether requests changing a book must provide an If-Match header.
+	requireIfMatch bool
+	// the configuration settings for the trash.
+	trash struct {
+		// how long deleted books are kept, zero keeps them forever.
+		retention time.Duration
+		// how often the books past the retention period are purged.
+		purgeInterval time.Duration
+	}
 }
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	"net/http"
+	"strings"
+)
+
+// maxBatchOperations is the number of operations a batch may hold in total.
+const maxBatchOperations = 100
+
+// batchOutcome is the result of an operation of a batch, along with the book
+// the operation saved.
+type batchOutcome struct {
+	result BatchBookResult
+	book   *data.Book
+}
+
+// failed reports whether the operation failed.
+func (o batchOutcome) failed() bool {
+	return o.result.Status >= http.StatusBadRequest
+}
+
+func (app *application) BatchBookHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload BatchBookRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	mode := Atomic
+	if payload.Mode != nil {
+		mode = *payload.Mode
+	}
+	var creates []CreateBookRequest
+	if payload.Create != nil {
+		creates = *payload.Create
+	}
+	var updates []BatchUpdateBookRequest
+	if payload.Update != nil {
+		updates = *payload.Update
+	}
+	var deletes []BatchDeleteBookRequest
+	if payload.Delete != nil {
+		deletes = *payload.Delete
+	}
+
+	v := validator.New()
+	v.Check(validator.PermittedValue(mode, Atomic, Partial), "mode", "must be either atomic or partial")
+	total := len(creates) + len(updates) + len(deletes)
+	v.Check(total > 0, "operations", "must be provided")
+	v.Check(total <= maxBatchOperations, "operations", fmt.Sprintf("must not be more than %d in total", maxBatchOperations))
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	resp, err := app.runBatch(r.Context(), userID, mode, creates, updates, deletes)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// runBatch runs the operations of a batch in a single transaction, which is
+// committed unless the batch is atomic and one of its operations failed. An
+// unexpected error stops the whole batch and leaves the library as it was.
+func (app *application) runBatch(ctx context.Context, userID uuid.UUID, mode BatchMode, creates []CreateBookRequest, updates []BatchUpdateBookRequest, deletes []BatchDeleteBookRequest) (BatchBookResponse, error) {
+	tx, err := app.db.BeginTx(ctx, nil)
+	if err != nil {
+		return BatchBookResponse{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := app.queries.WithTx(tx)
+
+	var created, updated, deleted []batchOutcome
+	for i, req := range creates {
+		outcome, err := runBatchOperation(ctx, tx, i, func() (batchOutcome, error) {
+			return batchCreateBook(ctx, qtx, userID, req)
+		})
+		if err != nil {
+			return BatchBookResponse{}, err
+		}
+		created = append(created, outcome)
+	}
+	for i, req := range updates {
+		outcome, err := runBatchOperation(ctx, tx, i, func() (batchOutcome, error) {
+			return batchUpdateBook(ctx, qtx, userID, req)
+		})
+		if err != nil {
+			return BatchBookResponse{}, err
+		}
+		updated = append(updated, outcome)
+	}
+	for i, req := range deletes {
+		outcome, err := runBatchOperation(ctx, tx, i, func() (batchOutcome, error) {
+			return batchDeleteBook(ctx, qtx, userID, req)
+		})
+		if err != nil {
+			return BatchBookResponse{}, err
+		}
+		deleted = append(deleted, outcome)
+	}
+
+	resp := BatchBookResponse{Mode: mode}
+	for _, outcomes := range [][]batchOutcome{created, updated, deleted} {
+		for _, outcome := range outcomes {
+			if outcome.failed() {
+				resp.Failed++
+			} else {
+				resp.Succeeded++
+			}
+		}
+	}
+
+	resp.Committed = mode == Partial || resp.Failed == 0
+
+	// The results are loaded before the transaction is committed, so that once
+	// the books are saved the response can no longer fail and lead the client
+	// to send the batch again.
+	if resp.Create, err = batchResults(ctx, qtx, created, resp.Committed); err != nil {
+		return BatchBookResponse{}, err
+	}
+	if resp.Update, err = batchResults(ctx, qtx, updated, resp.Committed); err != nil {
+		return BatchBookResponse{}, err
+	}
+	if resp.Delete, err = batchResults(ctx, qtx, deleted, resp.Committed); err != nil {
+		return BatchBookResponse{}, err
+	}
+
+	if resp.Committed {
+		if err := tx.Commit(); err != nil {
+			return BatchBookResponse{}, err
+		}
+	}
+
+	years := make(map[int]bool)
+	for _, outcome := range created {
+		if resp.Committed && !outcome.failed() && ReadingStatus(outcome.book.Status) == Finished {
+			years[outcome.book.FinishedAt.Time.Year()] = true
+		}
+	}
+	for year := range years {
+		app.checkGoals(userID, year)
+	}
+
+	return resp, nil
+}
+
+// runBatchOperation runs an operation of a batch under a savepoint, so that an
+// operation rejected by the database only fails itself.
+func runBatchOperation(ctx context.Context, tx *sql.Tx, index int, operation func() (batchOutcome, error)) (batchOutcome, error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_operation"); err != nil {
+		return batchOutcome{}, err
+	}
+
+	outcome, err := operation()
+	if err != nil {
+		return batchOutcome{}, err
+	}
+	outcome.result.Index = index
+
+	if outcome.failed() {
+		_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_operation")
+	} else {
+		_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_operation")
+	}
+	if err != nil {
+		return batchOutcome{}, err
+	}
+
+	return outcome, nil
+}
+
+// batchResults maps the outcomes of the operations to their results, loading
+// the books they saved when the batch is committed. When it is not, the
+// operations that succeeded report that they were not saved instead.
+func batchResults(ctx context.Context, q *data.Queries, outcomes []batchOutcome, committed bool) ([]BatchBookResult, error) {
+	results := make([]BatchBookResult, 0, len(outcomes))
+
+	for _, outcome := range outcomes {
+		result := outcome.result
+
+		switch {
+		case outcome.failed():
+		case !committed:
+			result = batchFailure(http.StatusFailedDependency, "not saved because another operation of the batch failed")
+			result.Index = outcome.result.Index
+		case outcome.book != nil:
+			book, err := loadBookResponse(ctx, q, *outcome.book)
+			if err != nil {
+				return nil, err
+			}
+			result.Book = &book
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// batchCreateBook creates a book of a batch.
+func batchCreateBook(ctx context.Context, q *data.Queries, userID uuid.UUID, req CreateBookRequest) (batchOutcome, error) {
+	v := validator.New()
+	validateCreateBookRequest(req, v)
+	if !v.Valid() {
+		return batchOutcome{result: batchValidationFailure(v.Errors)}, nil
+	}
+
+	book, err := q.CreateBook(ctx, newCreateBookParams(userID, req))
+	if err != nil {
+		if message, ok := bookConflictMessage(err); ok {
+			return batchOutcome{result: batchFailure(http.StatusConflict, message)}, nil
+		}
+		return batchOutcome{}, err
+	}
+
+	return batchOutcome{result: BatchBookResult{Status: http.StatusCreated}, book: &book}, nil
+}
+
+// batchUpdateBook updates a book of a batch.
+func batchUpdateBook(ctx context.Context, q *data.Queries, userID uuid.UUID, req BatchUpdateBookRequest) (batchOutcome, error) {
+	update := UpdateBookRequest{
+		Name:          req.Name,
+		Author:        req.Author,
+		Isbn:          req.Isbn,
+		Publisher:     req.Publisher,
+		PublishedYear: req.PublishedYear,
+		PageCount:     req.PageCount,
+		Language:      req.Language,
+		Genre:         req.Genre,
+	}
+
+	v := validator.New()
+	validateUpdateBookRequest(update, v)
+	validateBatchVersion(req.Version, v)
+	if !v.Valid() {
+		return batchOutcome{result: batchValidationFailure(v.Errors)}, nil
+	}
+
+	book, failure, err := getBatchBook(ctx, q, userID, req.Id, req.Version)
+	if err != nil {
+		return batchOutcome{}, err
+	}
+	if failure.Status != 0 {
+		return batchOutcome{result: failure}, nil
+	}
+
+	book, err = q.UpdateBook(ctx, data.UpdateBookParams{
+		Name:          update.Name,
+		Author:        nullString(update.Author),
+		Isbn:          nullString(canonicalISBN(update.Isbn)),
+		Publisher:     nullString(update.Publisher),
+		PublishedYear: nullInt32(update.PublishedYear),
+		PageCount:     nullInt32(update.PageCount),
+		Language:      nullString(update.Language),
+		Genre:         nullString(update.Genre),
+		ID:            book.ID,
+		Version:       book.Version,
+		UserID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return batchOutcome{result: batchFailure(http.StatusConflict, "unable to update the record due to an edit conflict, please try again")}, nil
+		}
+		if message, ok := bookConflictMessage(err); ok {
+			return batchOutcome{result: batchFailure(http.StatusConflict, message)}, nil
+		}
+		return batchOutcome{}, err
+	}
+
+	return batchOutcome{result: BatchBookResult{Status: http.StatusOK}, book: &book}, nil
+}
+
+// batchDeleteBook moves a book of a batch to the trash.
+func batchDeleteBook(ctx context.Context, q *data.Queries, userID uuid.UUID, req BatchDeleteBookRequest) (batchOutcome, error) {
+	v := validator.New()
+	validateBatchVersion(req.Version, v)
+	if !v.Valid() {
+		return batchOutcome{result: batchValidationFailure(v.Errors)}, nil
+	}
+
+	book, failure, err := getBatchBook(ctx, q, userID, req.Id, req.Version)
+	if err != nil {
+		return batchOutcome{}, err
+	}
+	if failure.Status != 0 {
+		return batchOutcome{result: failure}, nil
+	}
+
+	rowsAffected, err := q.TrashBook(ctx, data.TrashBookParams{ID: book.ID, UserID: userID, Version: book.Version})
+	if err != nil {
+		return batchOutcome{}, err
+	}
+	if rowsAffected == 0 {
+		return batchOutcome{result: batchFailure(http.StatusConflict, "unable to update the record due to an edit conflict, please try again")}, nil
+	}
+
+	return batchOutcome{result: BatchBookResult{Status: http.StatusOK}}, nil
+}
+
+// getBatchBook retrieves the book an operation of a batch changes, returning the
+// failure of the operation when the book does not exist, belongs to another user
+// or is no longer at the version the operation was made against. The failure has
+// no status when the operation can go ahead.
+func getBatchBook(ctx context.Context, q *data.Queries, userID, id uuid.UUID, version *int) (data.Book, BatchBookResult, error) {
+	book, err := q.GetBook(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return data.Book{}, batchFailure(http.StatusNotFound, "the requested resource could not be found"), nil
+		}
+		return data.Book{}, BatchBookResult{}, err
+	}
+
+	if userID.String() != book.UserID.String() {
+		return data.Book{}, batchFailure(http.StatusForbidden, "your user account does not have the necessary permissions to access this resource"), nil
+	}
+
+	if version != nil && int32(*version) != book.Version {
+		return data.Book{}, batchFailure(http.StatusConflict, fmt.Sprintf("the book is at version %d, not version %d", book.Version, *version)), nil
+	}
+
+	return book, BatchBookResult{}, nil
+}
+
+func validateBatchVersion(version *int, v *validator.Validator) {
+	if version != nil {
+		v.Check(*version > 0, "version", "must be greater than zero")
+	}
+}
+
+// bookConflictMessage returns the message of the conflict when the error is the
+// violation of one of the unique constraints of the books of a user.
+func bookConflictMessage(err error) (string, bool) {
+	switch {
+	case strings.Contains(err.Error(), "books_user_id_name_key"):
+		return "Book already exists", true
+	case strings.Contains(err.Error(), "books_user_id_isbn_key"):
+		return "Book with this ISBN already exists", true
+	default:
+		return "", false
+	}
+}
+
+// batchFailure returns the result of an operation that failed with the status.
+func batchFailure(status int, message string) BatchBookResult {
+	return BatchBookResult{Status: status, Message: &message}
+}
+
+// batchValidationFailure returns the result of an operation that failed validation.
+func batchValidationFailure(errs map[string]string) BatchBookResult {
+	result := batchFailure(http.StatusUnprocessableEntity, "the provided input failed validation check")
+	result.Errors = &errs
+	return result
+}
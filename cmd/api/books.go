@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,9 +11,17 @@ import (
 	openapitypes "github.com/oapi-codegen/runtime/types"
 	"math"
 	"net/http"
-	"strings"
+	"time"
 )
 
+// bookSortSafelist holds the values accepted by the sort parameter of the book list,
+// a leading hyphen sorts in descending order. Relevance always puts the best match first.
+var bookSortSafelist = []string{
+	"name", "author", "created_at", "updated_at", "rating",
+	"-name", "-author", "-created_at", "-updated_at", "-rating",
+	"relevance",
+}
+
 func (app *application) ListBookHandler(w http.ResponseWriter, r *http.Request, params ListBookHandlerParams) {
 	userID, err := app.contextGetUserID(r)
 	if err != nil || userID == uuid.Nil {
@@ -27,41 +36,140 @@ func (app *application) ListBookHandler(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	filters := newBookFilters(userID, params)
+
+	app.listBooks(w, r, filters, params.Page, params.PageSize, params.Cursor, params.IncludeTotal)
+}
+
+// newBookFilters maps the filters of the book list parameters to the parameters
+// of the book list queries, leaving the page to be set by the caller.
+func newBookFilters(userID uuid.UUID, params ListBookHandlerParams) data.ListBookForUserParams {
 	var searchName string
 	if params.Name != nil {
 		searchName = *params.Name
 	}
+	var searchMode = Websearch
+	if params.SearchMode != nil {
+		searchMode = *params.SearchMode
+	}
+	var isbn string
+	if params.Isbn != nil {
+		isbn = *canonicalISBN(params.Isbn)
+	}
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	var shelfID uuid.NullUUID
+	if params.ShelfId != nil {
+		shelfID = uuid.NullUUID{UUID: *params.ShelfId, Valid: true}
+	}
+	var tags []string
+	if params.Tags != nil {
+		tags = normalizeTags(*params.Tags)
+	}
+	var sort = "name"
+	if searchName != "" {
+		sort = "relevance"
+	}
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	return data.ListBookForUserParams{
+		UserID:       userID,
+		Search:       searchName,
+		SearchMode:   string(searchMode),
+		Isbn:         isbn,
+		Status:       status,
+		ShelfID:      shelfID,
+		Tags:         tags,
+		MatchAllTags: params.TagMode != nil && *params.TagMode == All,
+		Sort:         sort,
+	}
+}
+
+// listBooks retrieves the requested page of books matching the provided filters
+// and sends them to the client. Pages are either addressed by their number, with
+// the pagination metadata in the response, or by the cursor of the previous page,
+// which allows the counting of the matching books to be skipped.
+func (app *application) listBooks(w http.ResponseWriter, r *http.Request, filters data.ListBookForUserParams, pageParam, pageSizeParam *int, cursorParam *string, includeTotalParam *bool) {
 	var page = 1
-	if params.Page != nil {
-		page = *params.Page
+	if pageParam != nil {
+		page = *pageParam
 	}
 	var pageSize = 10
-	if params.PageSize != nil {
-		pageSize = *params.PageSize
+	if pageSizeParam != nil {
+		pageSize = *pageSizeParam
+	}
+	includeTotal := includeTotalParam == nil || *includeTotalParam
+
+	if cursorParam != nil || !includeTotal {
+		v := validator.New()
+		v.Check(pageParam == nil, "page", "must not be provided together with cursor or include_total=false")
+
+		var cursor *bookCursor
+		if cursorParam != nil {
+			decoded, err := decodeBookCursor(*cursorParam)
+			switch {
+			case err != nil:
+				v.AddError("cursor", "is invalid")
+			case decoded.Sort != filters.Sort:
+				v.AddError("cursor", "does not match the sort")
+			}
+			cursor = &decoded
+		}
+
+		if !v.Valid() {
+			app.failedValidationResponse(w, r, v.Errors)
+			return
+		}
+
+		app.listBooksByCursor(w, r, filters, pageSize, cursor, includeTotal)
+		return
 	}
 
-	rows, err := app.queries.ListBookForUser(r.Context(), data.ListBookForUserParams{
-		UserID:         userID,
-		PlaintoTsquery: searchName,
-		Limit:          int32(pageSize),
-		Offset:         int32((page - 1) * pageSize),
-	})
+	filters.Limit = int32(pageSize)
+	filters.Offset = int32((page - 1) * pageSize)
+
+	// The time the library last changed is read before the books, so that it
+	// is never more recent than the books that are sent.
+	lastModified, err := app.queries.GetLibraryModifiedAt(r.Context(), filters.UserID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	rows, err := app.queries.ListBookForUser(r.Context(), filters)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	bookIDs := make([]uuid.UUID, 0, len(rows))
+	for _, value := range rows {
+		bookIDs = append(bookIDs, value.Book.ID)
+	}
 
+	ratings, err := app.queries.ListAverageRatingForBooks(r.Context(), bookIDs)
 	if err != nil {
 		app.serverError(w, r, err)
 		return
 	}
 
+	averageRatings := make(map[uuid.UUID]float64, len(ratings))
+	for _, rating := range ratings {
+		averageRatings[rating.BookID] = rating.AverageRating
+	}
+
 	var totalRecords int64
 	var books []BookResponse
 	for _, value := range rows {
 		totalRecords = value.TotalRecords
-		book := BookResponse{
-			Id:        value.ID,
-			Name:      value.Name,
-			UserId:    value.UserID,
-			CreatedAt: value.CreatedAt,
-			UpdatedAt: value.UpdatedAt,
+		book := newBookResponse(value.Book, value.Tags)
+		book.Headline = stringPtr(value.Headline)
+		if rating, ok := averageRatings[value.Book.ID]; ok {
+			book.AverageRating = &rating
 		}
 		books = append(books, book)
 	}
@@ -72,11 +180,102 @@ func (app *application) ListBookHandler(w http.ResponseWriter, r *http.Request,
 
 	pagination := calculateMetadata(int(totalRecords), page, pageSize)
 	resp := ListBookResponse{
-		Metadata: pagination,
+		Metadata: &pagination,
 		Items:    books,
 	}
 
-	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+	// The cursor lets clients switch to cursor paging after any page.
+	if page*pageSize < int(totalRecords) && len(rows) > 0 {
+		last := rows[len(rows)-1]
+		var rating sql.NullFloat64
+		if value, ok := averageRatings[last.Book.ID]; ok {
+			rating = sql.NullFloat64{Float64: value, Valid: true}
+		}
+		nextCursor := newBookCursor(filters.Sort, last.Book, rating, last.Rank).encode()
+		resp.NextCursor = &nextCursor
+	}
+
+	if err := app.writeCachedJSON(w, r, resp, "", lastModified); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// newBookCursorParams returns the parameters fetching the first limit books
+// matching the provided filters in the order of their cursor.
+func newBookCursorParams(filters data.ListBookForUserParams, limit int) data.ListBookForUserByCursorParams {
+	return data.ListBookForUserByCursorParams{
+		UserID:       filters.UserID,
+		Search:       filters.Search,
+		SearchMode:   filters.SearchMode,
+		Isbn:         filters.Isbn,
+		Status:       filters.Status,
+		ShelfID:      filters.ShelfID,
+		Tags:         filters.Tags,
+		MatchAllTags: filters.MatchAllTags,
+		Sort:         filters.Sort,
+		Limit:        int32(limit),
+	}
+}
+
+// listBooksByCursor retrieves the books matching the provided filters that come
+// right after the cursor, or the first books when there is no cursor. One more book
+// than requested is fetched to find out whether there is a next page.
+func (app *application) listBooksByCursor(w http.ResponseWriter, r *http.Request, filters data.ListBookForUserParams, pageSize int, cursor *bookCursor, includeTotal bool) {
+	params := newBookCursorParams(filters, pageSize+1)
+	if cursor != nil {
+		cursor.apply(&params)
+	}
+
+	lastModified, err := app.queries.GetLibraryModifiedAt(r.Context(), filters.UserID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	rows, err := app.queries.ListBookForUserByCursor(r.Context(), params)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	var resp ListBookResponse
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[len(rows)-1]
+		nextCursor := newBookCursor(filters.Sort, last.Book, last.AverageRating, last.Rank).encode()
+		resp.NextCursor = &nextCursor
+	}
+
+	resp.Items = make([]BookResponse, 0, len(rows))
+	for _, value := range rows {
+		book := newBookResponse(value.Book, value.Tags)
+		book.Headline = stringPtr(value.Headline)
+		if value.AverageRating.Valid {
+			book.AverageRating = &value.AverageRating.Float64
+		}
+		resp.Items = append(resp.Items, book)
+	}
+
+	if includeTotal {
+		total, err := app.queries.CountBookForUser(r.Context(), data.CountBookForUserParams{
+			UserID:       filters.UserID,
+			Search:       filters.Search,
+			SearchMode:   filters.SearchMode,
+			Isbn:         filters.Isbn,
+			Status:       filters.Status,
+			ShelfID:      filters.ShelfID,
+			Tags:         filters.Tags,
+			MatchAllTags: filters.MatchAllTags,
+		})
+		if err != nil {
+			app.serverError(w, r, err)
+			return
+		}
+		totalItems := int(total)
+		resp.TotalItems = &totalItems
+	}
+
+	if err := app.writeCachedJSON(w, r, resp, "", lastModified); err != nil {
 		app.serverError(w, r, err)
 	}
 }
@@ -95,45 +294,38 @@ func (app *application) CreateBookHandler(w http.ResponseWriter, r *http.Request
 	}
 
 	v := validator.New()
-	v.Check(payload.Name != "", "name", "must be provided")
-	v.Check(len(payload.Name) <= 500, "name", "must not be more than 500 bytes")
+	validateCreateBookRequest(payload, v)
 	if !v.Valid() {
 		app.failedValidationResponse(w, r, v.Errors)
 		return
 	}
 
-	row, err := app.queries.CreateBook(r.Context(), data.CreateBookParams{
-		UserID: userID,
-		Name:   payload.Name,
-	})
-
+	book, err := app.queries.CreateBook(r.Context(), newCreateBookParams(userID, payload))
 	if err != nil {
+		message, conflict := bookConflictMessage(err)
 		switch {
-		case strings.Contains(err.Error(), "books_user_id_name_key"):
-			app.errorResponse(w, r, http.StatusConflict, Error{Message: "Book already exists"})
+		case conflict:
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: message})
 		default:
 			app.serverError(w, r, err)
 		}
 		return
 	}
 
-	header := make(http.Header)
-	header.Set("Location", fmt.Sprintf("/books/%s", row.ID))
-
-	resp := BookResponse{
-		Id:        row.ID,
-		Name:      payload.Name,
-		CreatedAt: row.CreatedAt,
-		UpdatedAt: row.UpdatedAt,
-		UserId:    userID,
+	if ReadingStatus(book.Status) == Finished {
+		app.checkGoals(userID, book.FinishedAt.Time.Year())
 	}
 
-	if err := app.writeJSON(w, http.StatusCreated, resp, header); err != nil {
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/books/%s", book.ID))
+	header.Set("ETag", bookETag(book))
+
+	if err := app.writeJSON(w, http.StatusCreated, newBookResponse(book, nil), header); err != nil {
 		app.serverError(w, r, err)
 	}
 }
 
-func (app *application) DeleteBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+func (app *application) DeleteBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params DeleteBookHandlerParams) {
 	userID, err := app.contextGetUserID(r)
 	if err != nil || userID == uuid.Nil {
 		app.authenticationRequiredResponse(w, r)
@@ -156,13 +348,24 @@ func (app *application) DeleteBookHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if err = app.queries.DeleteBook(r.Context(), data.DeleteBookParams{ID: id, UserID: userID}); err != nil {
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	rowsAffected, err := app.queries.TrashBook(r.Context(), data.TrashBookParams{ID: id, UserID: userID, Version: book.Version})
+	if err != nil {
 		app.serverError(w, r, err)
 		return
 	}
 
+	// The book was changed or deleted after it was read.
+	if rowsAffected == 0 {
+		app.editConflictResponse(w, r)
+		return
+	}
+
 	resp := map[string]string{
-		"message": "Book deleted successfully",
+		"message": "Book moved to the trash",
 	}
 
 	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
@@ -193,20 +396,18 @@ func (app *application) GetBookHandler(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
-	resp := BookResponse{
-		Id:        book.ID,
-		Name:      book.Name,
-		CreatedAt: book.CreatedAt,
-		UpdatedAt: book.UpdatedAt,
-		UserId:    book.UserID,
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
 	}
 
-	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+	if err := app.writeCachedJSON(w, r, resp, bookETag(book), book.UpdatedAt); err != nil {
 		app.serverError(w, r, err)
 	}
 }
 
-func (app *application) UpdateBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+func (app *application) UpdateBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params UpdateBookHandlerParams) {
 	userID, err := app.contextGetUserID(r)
 	if err != nil || userID == uuid.Nil {
 		app.authenticationRequiredResponse(w, r)
@@ -220,8 +421,7 @@ func (app *application) UpdateBookHandler(w http.ResponseWriter, r *http.Request
 	}
 
 	v := validator.New()
-	v.Check(payload.Name != "", "name", "must be provided")
-	v.Check(len(payload.Name) <= 500, "name", "must not be more than 500 bytes")
+	validateUpdateBookRequest(payload, v)
 	if !v.Valid() {
 		app.failedValidationResponse(w, r, v.Errors)
 		return
@@ -243,47 +443,316 @@ func (app *application) UpdateBookHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	book, err = app.queries.UpdateBook(r.Context(), data.UpdateBookParams{
-		Name:    payload.Name,
-		ID:      book.ID,
-		Version: book.Version,
-		UserID:  userID,
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	app.updateBook(w, r, book, payload)
+}
+
+func (app *application) PatchBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params PatchBookHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	if mediaType := requestMediaType(r); mediaType != mergePatchMediaType && mediaType != jsonPatchMediaType {
+		app.unsupportedMediaTypeResponse(w, r, mergePatchMediaType, jsonPatchMediaType)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	// The patch is applied to the book as it would be sent to UpdateBookHandler.
+	payload := UpdateBookRequest{
+		Name:          book.Name,
+		Author:        stringPtr(book.Author),
+		Isbn:          stringPtr(book.Isbn),
+		Publisher:     stringPtr(book.Publisher),
+		PublishedYear: intPtr(book.PublishedYear),
+		PageCount:     intPtr(book.PageCount),
+		Language:      stringPtr(book.Language),
+		Genre:         stringPtr(book.Genre),
+	}
+	if err := app.readJSON(w, r, &payload); err != nil {
+		switch {
+		case errors.Is(err, errPatchTestFailed):
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: err.Error()})
+		default:
+			app.badRequestResponse(w, r, err)
+		}
+		return
+	}
+
+	v := validator.New()
+	validateUpdateBookRequest(payload, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	app.updateBook(w, r, book, payload)
+}
+
+// updateBook replaces the fields of the book with the ones of the validated
+// request, as long as the book is still at the version it was retrieved at,
+// and sends the updated book to the client.
+func (app *application) updateBook(w http.ResponseWriter, r *http.Request, book data.Book, payload UpdateBookRequest) {
+	book, err := app.queries.UpdateBook(r.Context(), data.UpdateBookParams{
+		Name:          payload.Name,
+		Author:        nullString(payload.Author),
+		Isbn:          nullString(canonicalISBN(payload.Isbn)),
+		Publisher:     nullString(payload.Publisher),
+		PublishedYear: nullInt32(payload.PublishedYear),
+		PageCount:     nullInt32(payload.PageCount),
+		Language:      nullString(payload.Language),
+		Genre:         nullString(payload.Genre),
+		ID:            book.ID,
+		Version:       book.Version,
+		UserID:        book.UserID,
 	})
 
 	if err != nil {
+		message, conflict := bookConflictMessage(err)
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
 			app.editConflictResponse(w, r)
+		case conflict:
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: message})
 		default:
 			app.serverError(w, r, err)
 		}
 		return
 	}
 
-	resp := BookResponse{
-		Id:        book.ID,
-		Name:      book.Name,
-		CreatedAt: book.CreatedAt,
-		UpdatedAt: book.UpdatedAt,
-		UserId:    book.UserID,
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
 	}
 
-	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+	header := make(http.Header)
+	header.Set("ETag", bookETag(book))
+
+	if err := app.writeJSON(w, http.StatusOK, resp, header); err != nil {
 		app.serverError(w, r, err)
 	}
 }
 
-func validateListBookParams(params ListBookHandlerParams, v *validator.Validator) {
-	if params.Page != nil {
-		v.Check(*params.Page > 0, "page", "must be greater than zero")
+// newCreateBookParams maps a create book request to the parameters of the query
+// creating the book, starting the reading dates that match its status.
+func newCreateBookParams(userID uuid.UUID, payload CreateBookRequest) data.CreateBookParams {
+	status := WantToRead
+	if payload.Status != nil {
+		status = *payload.Status
+	}
+	startedAt, finishedAt := initialReadingDates(status, time.Now())
+
+	// A book created as finished has been read to the end, as when a book is
+	// moved to finished.
+	var currentPage, progressPercent sql.NullInt32
+	if status == Finished {
+		currentPage = nullInt32(payload.PageCount)
+		progressPercent = sql.NullInt32{Int32: 100, Valid: true}
+	}
+
+	return data.CreateBookParams{
+		UserID:          userID,
+		Name:            payload.Name,
+		Author:          nullString(payload.Author),
+		Isbn:            nullString(canonicalISBN(payload.Isbn)),
+		Publisher:       nullString(payload.Publisher),
+		PublishedYear:   nullInt32(payload.PublishedYear),
+		PageCount:       nullInt32(payload.PageCount),
+		Language:        nullString(payload.Language),
+		Genre:           nullString(payload.Genre),
+		Status:          string(status),
+		CurrentPage:     currentPage,
+		ProgressPercent: progressPercent,
+		StartedAt:       startedAt,
+		FinishedAt:      finishedAt,
 	}
-	if params.PageSize != nil {
-		v.Check(*params.PageSize > 0, "page_size", "must be greater than zero")
-		v.Check(*params.PageSize <= 100, "page_size", "must be a maximum of 100")
+}
+
+func validateCreateBookRequest(r CreateBookRequest, v *validator.Validator) {
+	validateBookName(r.Name, v)
+	validateBookMetadata(r.Author, r.Isbn, r.Publisher, r.PublishedYear, r.PageCount, r.Language, r.Genre, v)
+	if r.Status != nil {
+		validateReadingStatus(*r.Status, v)
 	}
+}
+
+func validateUpdateBookRequest(r UpdateBookRequest, v *validator.Validator) {
+	validateBookName(r.Name, v)
+	validateBookMetadata(r.Author, r.Isbn, r.Publisher, r.PublishedYear, r.PageCount, r.Language, r.Genre, v)
+}
+
+func validateBookName(name string, v *validator.Validator) {
+	v.Check(name != "", "name", "must be provided")
+	v.Check(len(name) <= 500, "name", "must not be more than 500 bytes")
+}
+
+// validateBookMetadata checks the optional bibliographic fields of a book,
+// only validating the fields that were provided.
+func validateBookMetadata(author, isbn, publisher *string, publishedYear, pageCount *int, language, genre *string, v *validator.Validator) {
+	if author != nil {
+		v.Check(*author != "", "author", "must not be empty")
+		v.Check(len(*author) <= 500, "author", "must not be more than 500 bytes")
+	}
+	if isbn != nil {
+		validateISBN(*isbn, v)
+	}
+	if publisher != nil {
+		v.Check(*publisher != "", "publisher", "must not be empty")
+		v.Check(len(*publisher) <= 500, "publisher", "must not be more than 500 bytes")
+	}
+	if publishedYear != nil {
+		v.Check(*publishedYear > 0, "published_year", "must be greater than zero")
+		v.Check(*publishedYear <= time.Now().Year()+1, "published_year", "must not be in the future")
+	}
+	if pageCount != nil {
+		v.Check(*pageCount > 0, "page_count", "must be greater than zero")
+		v.Check(*pageCount <= 100_000, "page_count", "must not be more than 100000")
+	}
+	if language != nil {
+		v.Check(*language != "", "language", "must not be empty")
+		v.Check(len(*language) <= 100, "language", "must not be more than 100 bytes")
+	}
+	if genre != nil {
+		v.Check(*genre != "", "genre", "must not be empty")
+		v.Check(len(*genre) <= 100, "genre", "must not be more than 100 bytes")
+	}
+}
+
+func validateListBookParams(params ListBookHandlerParams, v *validator.Validator) {
+	validatePagination(params.Page, params.PageSize, v)
 	if params.Name != nil {
 		v.Check(len(*params.Name) <= 500, "name", "must be less than 500 characters")
 	}
+	if params.SearchMode != nil {
+		v.Check(validator.PermittedValue(*params.SearchMode, Websearch, Prefix, Fuzzy), "search_mode", "must be one of websearch, prefix or fuzzy")
+	}
+	if params.Isbn != nil {
+		validateISBN(*params.Isbn, v)
+	}
+	if params.Status != nil {
+		validateReadingStatus(*params.Status, v)
+	}
+	if params.Tags != nil {
+		validateTags(*params.Tags, v)
+	}
+	if params.TagMode != nil {
+		v.Check(validator.PermittedValue(*params.TagMode, All, Any), "tag_mode", "must be either all or any")
+	}
+	if params.Sort != nil {
+		v.Check(validator.PermittedValue(*params.Sort, bookSortSafelist...), "sort", "invalid sort value")
+	}
+}
+
+func validatePagination(page, pageSize *int, v *validator.Validator) {
+	if page != nil {
+		v.Check(*page > 0, "page", "must be greater than zero")
+	}
+	if pageSize != nil {
+		v.Check(*pageSize > 0, "page_size", "must be greater than zero")
+		v.Check(*pageSize <= 100, "page_size", "must be a maximum of 100")
+	}
+}
+
+func validateISBN(isbn string, v *validator.Validator) {
+	v.Check(isbn != "", "isbn", "must not be empty")
+	v.Check(len(isbn) <= 17, "isbn", "must not be more than 17 bytes")
+	v.Check(validator.ValidISBN(isbn), "isbn", "must be a valid ISBN-10 or ISBN-13")
+}
+
+// canonicalISBN returns the normalized ISBN-13 form of a valid ISBN, so that
+// the same book is stored and searched the same way however it was entered.
+func canonicalISBN(isbn *string) *string {
+	if isbn == nil {
+		return nil
+	}
+
+	normalized := validator.NormalizeISBN(*isbn)
+	if isbn13, err := validator.ISBN10To13(normalized); err == nil {
+		normalized = isbn13
+	}
+	return &normalized
+}
+
+// newBookResponse maps a book record and its tags to its API representation.
+func newBookResponse(book data.Book, tags []string) BookResponse {
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+
+	return BookResponse{
+		Id:              book.ID,
+		Name:            book.Name,
+		Author:          stringPtr(book.Author),
+		Isbn:            stringPtr(book.Isbn),
+		Publisher:       stringPtr(book.Publisher),
+		PublishedYear:   intPtr(book.PublishedYear),
+		PageCount:       intPtr(book.PageCount),
+		Language:        stringPtr(book.Language),
+		Genre:           stringPtr(book.Genre),
+		Status:          ReadingStatus(book.Status),
+		CurrentPage:     intPtr(book.CurrentPage),
+		ProgressPercent: intPtr(book.ProgressPercent),
+		StartedAt:       timePtr(book.StartedAt),
+		FinishedAt:      timePtr(book.FinishedAt),
+		Tags:            tags,
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
+		UserId:          book.UserID,
+		Version:         int(book.Version),
+		CoverUrls:       newCoverURLs(book),
+		CoverBlurhash:   stringPtr(book.CoverBlurhash),
+	}
+}
+
+// bookResponse loads the tags and the average rating of a single book
+// and maps it to its API representation.
+func (app *application) bookResponse(ctx context.Context, book data.Book) (BookResponse, error) {
+	return loadBookResponse(ctx, app.queries, book)
+}
+
+// loadBookResponse is bookResponse for the queries of a transaction, which see
+// the changes the transaction has not committed yet.
+func loadBookResponse(ctx context.Context, q *data.Queries, book data.Book) (BookResponse, error) {
+	tags, err := q.ListTagsForBook(ctx, book.ID)
+	if err != nil {
+		return BookResponse{}, err
+	}
+
+	ratings, err := q.ListAverageRatingForBooks(ctx, []uuid.UUID{book.ID})
+	if err != nil {
+		return BookResponse{}, err
+	}
+
+	resp := newBookResponse(book, tags)
+	if len(ratings) > 0 {
+		resp.AverageRating = &ratings[0].AverageRating
+	}
+	return resp, nil
 }
 
 // calculateMetadata calculates the appropriate pagination metadata
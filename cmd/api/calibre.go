@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/validator"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// errNotCalibreLibrary is returned when a file is not the metadata.db of a Calibre library.
+var errNotCalibreLibrary = errors.New("not a calibre library")
+
+// sqliteHeader is the string every SQLite database file starts with.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// calibreTables are the tables of a Calibre library the books are read from.
+var calibreTables = []string{
+	"books",
+	"authors", "books_authors_link",
+	"publishers", "books_publishers_link",
+	"languages", "books_languages_link",
+	"series", "books_series_link",
+	"tags", "books_tags_link",
+	"ratings", "books_ratings_link",
+	"identifiers",
+}
+
+// calibreUndefinedYear is the year Calibre stores for books without a publication date.
+const calibreUndefinedYear = 101
+
+func (app *application) ImportCalibreHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	if !calibreSupported {
+		app.errorResponse(w, r, http.StatusNotImplemented, Error{Message: "importing a Calibre library is not supported by this server"})
+		return
+	}
+
+	file, err := app.readImportFile(w, r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	// SQLite can only open a database from a file, so the upload is copied to a
+	// temporary file that is removed once the books have been read.
+	tmp, err := os.CreateTemp("", "calibre-*.db")
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, file)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	books, failed, err := readCalibreLibrary(r.Context(), tmp.Name())
+	if err != nil {
+		if !errors.Is(err, errNotCalibreLibrary) {
+			app.logger.Error(fmt.Sprintf("reading calibre library: %v", err))
+		}
+		v := validator.New()
+		v.AddError("file", "must be the metadata.db of a Calibre library")
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	app.sendImport(w, r, userID, "calibre", books, failed)
+}
+
+// readCalibreLibrary reads the books of the Calibre library in the metadata.db at
+// path. The ID of each book in the library is used as its row, and the books that
+// can not be read are returned as failed instead of stopping the whole library.
+func readCalibreLibrary(ctx context.Context, path string) ([]importedBook, []ImportIssue, error) {
+	header := make([]byte, len(sqliteHeader))
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = io.ReadFull(f, header)
+	f.Close()
+	if err != nil || !bytes.Equal(header, sqliteHeader) {
+		return nil, nil, errNotCalibreLibrary
+	}
+
+	// The library is opened as immutable so that SQLite neither writes to the
+	// file nor looks for the journal files it would have next to it.
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&immutable=1")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Close()
+
+	for _, table := range calibreTables {
+		var count int
+		err := db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+		if err != nil || count == 0 {
+			return nil, nil, errNotCalibreLibrary
+		}
+	}
+
+	authors, err := calibreValues(ctx, db, `
+		SELECT books_authors_link.book, authors.name
+		FROM books_authors_link
+		         JOIN authors ON authors.id = books_authors_link.author
+		ORDER BY books_authors_link.id`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publishers, err := calibreValues(ctx, db, `
+		SELECT books_publishers_link.book, publishers.name
+		FROM books_publishers_link
+		         JOIN publishers ON publishers.id = books_publishers_link.publisher`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	languages, err := calibreValues(ctx, db, `
+		SELECT books_languages_link.book, languages.lang_code
+		FROM books_languages_link
+		         JOIN languages ON languages.id = books_languages_link.lang_code
+		ORDER BY books_languages_link.item_order`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	series, err := calibreValues(ctx, db, `
+		SELECT books_series_link.book, series.name
+		FROM books_series_link
+		         JOIN series ON series.id = books_series_link.series`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tags, err := calibreValues(ctx, db, `
+		SELECT books_tags_link.book, tags.name
+		FROM books_tags_link
+		         JOIN tags ON tags.id = books_tags_link.tag
+		ORDER BY books_tags_link.id`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ratings, err := calibreValues(ctx, db, `
+		SELECT books_ratings_link.book, ratings.rating
+		FROM books_ratings_link
+		         JOIN ratings ON ratings.id = books_ratings_link.rating`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isbns, err := calibreValues(ctx, db, `
+		SELECT book, val
+		FROM identifiers
+		WHERE lower(type) = 'isbn'`)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, title, isbn, CAST(strftime('%Y', pubdate) AS INTEGER)
+		FROM books
+		ORDER BY id`)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var books []importedBook
+	var failed []ImportIssue
+
+	for rows.Next() {
+		var id int
+		var title string
+		var isbn sql.NullString
+		var year sql.NullInt32
+		if err := rows.Scan(&id, &title, &isbn, &year); err != nil {
+			return nil, nil, err
+		}
+
+		book := importedBook{
+			row:       id,
+			name:      strings.TrimSpace(title),
+			author:    optionalField(strings.Join(authors[id], " & ")),
+			publisher: firstValue(publishers[id]),
+			language:  firstValue(languages[id]),
+			status:    WantToRead,
+			tags:      normalizeTags(tags[id]),
+			shelves:   series[id],
+		}
+
+		// The isbn column of the books table is only kept for older libraries,
+		// newer versions of Calibre keep the ISBN with the other identifiers.
+		for _, value := range append(slices.Clone(isbns[id]), isbn.String) {
+			if value != "" && validator.ValidISBN(value) {
+				book.isbn = canonicalISBN(&value)
+				break
+			}
+		}
+
+		if year.Valid && year.Int32 > calibreUndefinedYear {
+			publishedYear := int(year.Int32)
+			book.publishedYear = &publishedYear
+		}
+
+		if value := firstValue(ratings[id]); value != nil {
+			rating, err := strconv.Atoi(*value)
+			if err != nil {
+				failed = append(failed, ImportIssue{Row: id, Reason: "rating: must be a number"})
+				continue
+			}
+			// Calibre rates books from 0 to 10 in half stars, and the ratings
+			// below one star have no equivalent in a review.
+			if rating >= 2 {
+				stars := float64(rating) / 2
+				book.rating = &stars
+			}
+		}
+
+		books = append(books, book)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return books, failed, nil
+}
+
+// calibreValues runs a query selecting the ID of a book and a value, returning
+// the values of each book in the order they were selected.
+func calibreValues(ctx context.Context, db *sql.DB, query string) (map[int][]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make(map[int][]string)
+	for rows.Next() {
+		var book int
+		var value sql.NullString
+		if err := rows.Scan(&book, &value); err != nil {
+			return nil, err
+		}
+		if value := strings.TrimSpace(value.String); value != "" {
+			values[book] = append(values[book], value)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// firstValue returns the first of the values, or nil when there are none.
+func firstValue(values []string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	return &values[0]
+}
@@ -0,0 +1,12 @@
+//go:build cgo
+
+package main
+
+import (
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// calibreSupported reports whether Calibre libraries can be imported. The SQLite
+// driver the libraries are read with is a binding to the C library, so it needs
+// cgo.
+const calibreSupported = true
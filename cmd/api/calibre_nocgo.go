@@ -0,0 +1,8 @@
+//go:build !cgo
+
+package main
+
+// calibreSupported reports whether Calibre libraries can be imported. The server
+// was built without cgo, which the SQLite driver the libraries are read with
+// needs, so the import of a Calibre library is refused.
+const calibreSupported = false
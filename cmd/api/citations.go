@@ -0,0 +1,491 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// citationPageSize is the number of books loaded at a time while writing the
+// citations of a search or a shelf.
+const citationPageSize = 500
+
+// citationContentTypes are the content types of the documents of each format.
+var citationContentTypes = map[CitationFormat]string{
+	Bibtex:  "application/x-bibtex; charset=utf-8",
+	Ris:     "application/x-research-info-systems; charset=utf-8",
+	CslJson: "application/vnd.citationstyles.csl+json",
+}
+
+// citationStopWords are the words skipped when picking the word of the name of a
+// book that goes into its citation key.
+var citationStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "on": true, "in": true, "and": true, "to": true,
+}
+
+// authorSeparatorRX matches the separators between the authors of a book.
+var authorSeparatorRX = regexp.MustCompile(`\s*(?:;|&|\band\b)\s*`)
+
+// bibtexEscaper escapes the characters that have a special meaning in BibTeX.
+var bibtexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+// citationName is the name of an author split into its parts.
+type citationName struct {
+	family string
+	given  string
+}
+
+// cslItem is a book in CSL-JSON.
+type cslItem struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	Title         string    `json:"title"`
+	Author        []cslName `json:"author,omitempty"`
+	Publisher     string    `json:"publisher,omitempty"`
+	Issued        *cslDate  `json:"issued,omitempty"`
+	ISBN          string    `json:"ISBN,omitempty"`
+	NumberOfPages string    `json:"number-of-pages,omitempty"`
+	Language      string    `json:"language,omitempty"`
+}
+
+// cslName is the name of an author in CSL-JSON.
+type cslName struct {
+	Family string `json:"family"`
+	Given  string `json:"given,omitempty"`
+}
+
+// cslDate is a date in CSL-JSON.
+type cslDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+// citationEncoder writes the citations of books to a document in one of the
+// citation formats, giving each book a citation key not used by the books
+// before it.
+type citationEncoder struct {
+	w      io.Writer
+	format CitationFormat
+	count  int
+	keys   map[string]bool
+}
+
+func (app *application) GetBookCitationHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params GetBookCitationHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	if params.Format != nil {
+		validateCitationFormat(*params.Format, v)
+	}
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	format := citationFormat(params.Format)
+	w.Header().Set("Content-Type", citationContentTypes[format])
+
+	enc := newCitationEncoder(w, format)
+	if err := enc.encode(book); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+	if err := enc.close(); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) ListBookCitationHandler(w http.ResponseWriter, r *http.Request, params ListBookCitationHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	listParams := ListBookHandlerParams{
+		Name:       params.Name,
+		SearchMode: params.SearchMode,
+		Isbn:       params.Isbn,
+		Status:     params.Status,
+		ShelfId:    params.ShelfId,
+		Tags:       params.Tags,
+		TagMode:    params.TagMode,
+		Sort:       params.Sort,
+	}
+
+	v := validator.New()
+	validateListBookParams(listParams, v)
+	if params.Format != nil {
+		validateCitationFormat(*params.Format, v)
+	}
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	app.writeCitations(w, r, newBookFilters(userID, listParams), citationFormat(params.Format))
+}
+
+func (app *application) ListShelfCitationHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListShelfCitationHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	if params.Format != nil {
+		validateCitationFormat(*params.Format, v)
+	}
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	filters := data.ListBookForUserParams{
+		UserID:  userID,
+		ShelfID: uuid.NullUUID{UUID: shelf.ID, Valid: true},
+		Sort:    "name",
+	}
+
+	app.writeCitations(w, r, filters, citationFormat(params.Format))
+}
+
+// writeCitations sends the citations of all the books matching the filters. The
+// books are loaded a page at a time and their citations written as they come,
+// so once the first page is sent an error can only end the document early.
+func (app *application) writeCitations(w http.ResponseWriter, r *http.Request, filters data.ListBookForUserParams, format CitationFormat) {
+	params := newBookCursorParams(filters, citationPageSize)
+
+	var enc *citationEncoder
+	for {
+		rows, err := app.queries.ListBookForUserByCursor(r.Context(), params)
+		if err != nil {
+			if enc == nil {
+				app.serverError(w, r, err)
+			} else {
+				app.logger.Error(err.Error())
+			}
+			return
+		}
+
+		if enc == nil {
+			w.Header().Set("Content-Type", citationContentTypes[format])
+			enc = newCitationEncoder(w, format)
+		}
+
+		for _, row := range rows {
+			if err := enc.encode(row.Book); err != nil {
+				app.logger.Error(err.Error())
+				return
+			}
+		}
+
+		if len(rows) < citationPageSize {
+			break
+		}
+		last := rows[len(rows)-1]
+		newBookCursor(filters.Sort, last.Book, last.AverageRating, last.Rank).apply(&params)
+	}
+
+	if err := enc.close(); err != nil {
+		app.logger.Error(err.Error())
+	}
+}
+
+func validateCitationFormat(format CitationFormat, v *validator.Validator) {
+	v.Check(validator.PermittedValue(format, Bibtex, Ris, CslJson), "format", "must be one of bibtex, ris or csl-json")
+}
+
+// citationFormat returns the requested citation format, BibTeX by default.
+func citationFormat(format *CitationFormat) CitationFormat {
+	if format == nil {
+		return Bibtex
+	}
+	return *format
+}
+
+func newCitationEncoder(w io.Writer, format CitationFormat) *citationEncoder {
+	return &citationEncoder{w: w, format: format, keys: make(map[string]bool)}
+}
+
+// encode writes the citation of the book. A citation key already given to an
+// earlier book is followed by the start of the ID of the book, as in
+// herbert1965dune5f3a9c1e, so that the book gets the same key in every document
+// where it shares its key. Keys are only unique within a document, as which of
+// the books sharing a key comes first depends on the sort and filters.
+func (e *citationEncoder) encode(book data.Book) error {
+	key := citationKey(book)
+	if e.keys[key] {
+		id := strings.ReplaceAll(book.ID.String(), "-", "")
+		suffixed := key + id[:8]
+		// The whole ID keeps the key unique when the start of it is not enough.
+		if e.keys[suffixed] {
+			suffixed = key + id
+		}
+		key = suffixed
+	}
+	e.keys[key] = true
+
+	var entry string
+	switch e.format {
+	case Ris:
+		entry = risEntry(key, book)
+	case CslJson:
+		js, err := json.Marshal(newCSLItem(key, book))
+		if err != nil {
+			return err
+		}
+		entry = string(js)
+	default:
+		entry = bibtexEntry(key, book)
+	}
+
+	var separator string
+	switch {
+	case e.format == CslJson && e.count == 0:
+		separator = "[\n"
+	case e.format == CslJson:
+		separator = ",\n"
+	case e.count > 0:
+		separator = "\n"
+	}
+	e.count++
+
+	_, err := io.WriteString(e.w, separator+entry)
+	return err
+}
+
+// close ends the document.
+func (e *citationEncoder) close() error {
+	if e.format != CslJson {
+		return nil
+	}
+	if e.count == 0 {
+		_, err := io.WriteString(e.w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+// bibtexEntry returns the BibTeX entry of the book.
+func bibtexEntry(key string, book data.Book) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "@book{%s,\n", key)
+
+	field := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "  %s = {%s},\n", name, bibtexEscaper.Replace(value))
+		}
+	}
+
+	authors := make([]string, 0)
+	for _, name := range splitAuthors(book.Author.String) {
+		if name.given == "" {
+			authors = append(authors, name.family)
+		} else {
+			authors = append(authors, name.family+", "+name.given)
+		}
+	}
+	field("author", strings.Join(authors, " and "))
+	field("title", book.Name)
+	field("publisher", book.Publisher.String)
+	field("year", formatCSVInt(book.PublishedYear))
+	field("isbn", book.Isbn.String)
+	field("pagetotal", formatCSVInt(book.PageCount))
+	field("language", book.Language.String)
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// risEntry returns the RIS record of the book.
+func risEntry(key string, book data.Book) string {
+	var b strings.Builder
+
+	field := func(tag, value string) {
+		// Each field of a RIS record takes a single line.
+		value = strings.Join(strings.Fields(value), " ")
+		if value != "" {
+			fmt.Fprintf(&b, "%s  - %s\r\n", tag, value)
+		}
+	}
+
+	field("TY", "BOOK")
+	field("ID", key)
+	for _, name := range splitAuthors(book.Author.String) {
+		if name.given == "" {
+			field("AU", name.family)
+		} else {
+			field("AU", name.family+", "+name.given)
+		}
+	}
+	field("TI", book.Name)
+	field("PB", book.Publisher.String)
+	field("PY", formatCSVInt(book.PublishedYear))
+	field("SN", book.Isbn.String)
+	field("LA", book.Language.String)
+	b.WriteString("ER  - \r\n")
+
+	return b.String()
+}
+
+// newCSLItem returns the CSL-JSON item of the book.
+func newCSLItem(key string, book data.Book) cslItem {
+	item := cslItem{
+		ID:            key,
+		Type:          "book",
+		Title:         book.Name,
+		Publisher:     book.Publisher.String,
+		ISBN:          book.Isbn.String,
+		NumberOfPages: formatCSVInt(book.PageCount),
+		Language:      book.Language.String,
+	}
+
+	for _, name := range splitAuthors(book.Author.String) {
+		item.Author = append(item.Author, cslName{Family: name.family, Given: name.given})
+	}
+	if book.PublishedYear.Valid {
+		item.Issued = &cslDate{DateParts: [][]int{{int(book.PublishedYear.Int32)}}}
+	}
+
+	return item
+}
+
+// splitAuthors splits the author of a book into the names of its authors, which
+// are separated by semicolons, ampersands or the word and. A name is either
+// written as "Family, Given" or as "Given Family", where the last word is taken
+// as the family name.
+func splitAuthors(author string) []citationName {
+	var names []citationName
+	for _, name := range authorSeparatorRX.Split(strings.TrimSpace(author), -1) {
+		if name == "" {
+			continue
+		}
+
+		if family, given, ok := strings.Cut(name, ","); ok {
+			names = append(names, citationName{family: strings.TrimSpace(family), given: strings.TrimSpace(given)})
+			continue
+		}
+
+		words := strings.Fields(name)
+		names = append(names, citationName{
+			family: words[len(words)-1],
+			given:  strings.Join(words[:len(words)-1], " "),
+		})
+	}
+	return names
+}
+
+// citationKey returns the citation key of the book, made of the family name of
+// its first author, its published year and the first word of its name that is
+// not a stop word, as in herbert1965dune. The key only depends on these fields,
+// so it stays the same as long as they do.
+func citationKey(book data.Book) string {
+	var key string
+	if names := splitAuthors(book.Author.String); len(names) > 0 {
+		key = citationKeyPart(names[0].family)
+	}
+	if book.PublishedYear.Valid {
+		key += strconv.Itoa(int(book.PublishedYear.Int32))
+	}
+
+	var first string
+	for _, word := range strings.Fields(book.Name) {
+		word = citationKeyPart(word)
+		if word == "" {
+			continue
+		}
+		if first == "" {
+			first = word
+		}
+		if !citationStopWords[word] {
+			first = word
+			break
+		}
+	}
+	key += first
+
+	if key == "" {
+		return "book"
+	}
+	return key
+}
+
+// citationKeyPart folds the text to the lowercase ASCII letters and digits
+// citation keys are made of, dropping the accents from accented letters.
+func citationKeyPart(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), s)
+	if err != nil {
+		folded = s
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(folded) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
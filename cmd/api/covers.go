@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/blob"
+	"github.com/hayohtee/books/internal/blurhash"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"regexp"
+)
+
+// maxCoverSize is the largest image accepted as the cover of a book.
+const maxCoverSize = 10 << 20
+
+// maxCoverDimension is the largest width and height of the image of a cover,
+// which keeps a small file from decoding into a huge image.
+const maxCoverDimension = 8000
+
+// coverFormats maps the media types of the images accepted as covers to their
+// format, which is also the extension of the file of the original image.
+var coverFormats = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+// coverThumbnails lists the thumbnails generated from a cover, by name and width.
+// An image narrower than a thumbnail is not scaled up.
+var coverThumbnails = []struct {
+	name  string
+	width int
+}{
+	{"small", 160},
+	{"medium", 320},
+	{"large", 640},
+}
+
+// coverKeyRX matches the keys of the covers, as generated by newCoverKey.
+var coverKeyRX = regexp.MustCompile("^[A-Z2-7]{26}$")
+
+func (app *application) PutBookCoverHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params PutBookCoverHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	mediaType := requestMediaType(r)
+	format, ok := coverFormats[mediaType]
+	if !ok {
+		app.unsupportedMediaTypeResponse(w, r, "image/jpeg", "image/png", "image/webp")
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	content, err := readCover(w, r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	img := decodeCover(content, mediaType, format, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	key, hash, err := app.storeCover(r.Context(), content, format, img)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	previous := book
+	book, err = app.queries.UpdateBookCover(r.Context(), data.UpdateBookCoverParams{
+		CoverKey:      sql.NullString{String: key, Valid: true},
+		CoverFormat:   sql.NullString{String: format, Valid: true},
+		CoverBlurhash: sql.NullString{String: hash, Valid: true},
+		ID:            book.ID,
+		Version:       book.Version,
+		UserID:        userID,
+	})
+	if err != nil {
+		app.deleteCover(key, format)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	// The files of the replaced cover are only deleted once no book points to them.
+	if previous.CoverKey.Valid {
+		app.deleteCover(previous.CoverKey.String, previous.CoverFormat.String)
+	}
+
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("ETag", bookETag(book))
+
+	if err := app.writeJSON(w, http.StatusOK, resp, header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetCoverHandler(w http.ResponseWriter, r *http.Request, key string, file GetCoverHandlerParamsFile) {
+	// The file is checked as well as the key, as an encoded slash in either
+	// would otherwise reach a blob outside the directory of the cover.
+	if !coverKeyRX.MatchString(key) || !validator.PermittedValue(file, OriginalJpeg, OriginalPng, OriginalWebp, SmallJpeg, MediumJpeg, LargeJpeg) {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	content, err := app.blobs.Get(r.Context(), path.Join("covers", key, string(file)))
+	if err != nil {
+		switch {
+		case errors.Is(err, blob.ErrNotFound):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+	defer content.Close()
+
+	mediaType := "image/jpeg"
+	for candidate, format := range coverFormats {
+		if path.Ext(string(file)) == "."+format {
+			mediaType = candidate
+		}
+	}
+
+	// The files of a cover never change, as a new cover gets a new key.
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content); err != nil {
+		app.logError(r, err)
+	}
+}
+
+// readCover reads the image of a cover from the body, with an error describing
+// the problem when it is too large.
+func readCover(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	extendUploadDeadlines(w, maxCoverSize)
+	r.Body = http.MaxBytesReader(w, r.Body, maxCoverSize)
+
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesError):
+			return nil, fmt.Errorf("cover must not be larger than %dMB", maxCoverSize>>20)
+		default:
+			return nil, err
+		}
+	}
+
+	return content, nil
+}
+
+// decodeCover checks that the content is an image of the media type that is not
+// too large, and decodes it.
+func decodeCover(content []byte, mediaType, format string, v *validator.Validator) image.Image {
+	v.Check(len(content) > 0, "cover", "must be provided")
+	if !v.Valid() {
+		return nil
+	}
+
+	// The content must be what the client says it is, rather than any image.
+	v.Check(http.DetectContentType(content) == mediaType, "cover", fmt.Sprintf("must be an image of the type %s", mediaType))
+	if !v.Valid() {
+		return nil
+	}
+
+	var decodeConfig func(io.Reader) (image.Config, error)
+	var decode func(io.Reader) (image.Image, error)
+	switch format {
+	case "jpeg":
+		decodeConfig, decode = jpeg.DecodeConfig, jpeg.Decode
+	case "png":
+		decodeConfig, decode = png.DecodeConfig, png.Decode
+	default:
+		decodeConfig, decode = webp.DecodeConfig, webp.Decode
+	}
+
+	config, err := decodeConfig(bytes.NewReader(content))
+	if err != nil {
+		v.AddError("cover", "must be a valid image")
+		return nil
+	}
+	v.Check(config.Width <= maxCoverDimension && config.Height <= maxCoverDimension, "cover", fmt.Sprintf("must not be larger than %dx%d pixels", maxCoverDimension, maxCoverDimension))
+	if !v.Valid() {
+		return nil
+	}
+
+	img, err := decode(bytes.NewReader(content))
+	if err != nil {
+		v.AddError("cover", "must be a valid image")
+		return nil
+	}
+
+	return img
+}
+
+// storeCover stores the original image of a cover along with its thumbnails
+// under a new key, and returns the key and the blurhash of the cover.
+func (app *application) storeCover(ctx context.Context, content []byte, format string, img image.Image) (string, string, error) {
+	key, err := newCoverKey()
+	if err != nil {
+		return "", "", err
+	}
+
+	hash, err := blurhash.Encode(scaleCover(img, 32), 4, 3)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := app.blobs.Put(ctx, coverBlobKey(key, "original", format), bytes.NewReader(content)); err != nil {
+		return "", "", err
+	}
+
+	for _, thumbnail := range coverThumbnails {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, scaleCover(img, thumbnail.width), &jpeg.Options{Quality: 85}); err != nil {
+			app.deleteCover(key, format)
+			return "", "", err
+		}
+
+		if err := app.blobs.Put(ctx, coverBlobKey(key, thumbnail.name, "jpeg"), &buf); err != nil {
+			app.deleteCover(key, format)
+			return "", "", err
+		}
+	}
+
+	return key, hash, nil
+}
+
+// deleteCover deletes the original image and the thumbnails of a cover, logging
+// the files that could not be deleted rather than failing the request.
+func (app *application) deleteCover(key, format string) {
+	keys := []string{coverBlobKey(key, "original", format)}
+	for _, thumbnail := range coverThumbnails {
+		keys = append(keys, coverBlobKey(key, thumbnail.name, "jpeg"))
+	}
+
+	for _, blobKey := range keys {
+		if err := app.blobs.Delete(context.Background(), blobKey); err != nil {
+			app.logger.Error(fmt.Sprintf("deleting cover file %s: %v", blobKey, err))
+		}
+	}
+}
+
+// scaleCover scales the image of a cover down to the width, keeping its aspect
+// ratio. The image is drawn over a white background, as JPEG thumbnails cannot
+// hold the transparency of a PNG or WebP image.
+func scaleCover(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	width = min(width, bounds.Dx())
+	height := max(1, int(math.Round(float64(bounds.Dy())*float64(width)/float64(bounds.Dx()))))
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+// newCoverKey generates the random key of a new cover. The key is what keeps the
+// files of a cover private, so it must be unguessable.
+func newCoverKey() (string, error) {
+	randomBytes := make([]byte, 16)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(randomBytes), nil
+}
+
+// coverBlobKey returns the key of a file of a cover in the blob store.
+func coverBlobKey(key, name, format string) string {
+	return path.Join("covers", key, name+"."+format)
+}
+
+// newCoverURLs returns the URLs of the files of the cover of a book, or nil when
+// the book has no cover.
+func newCoverURLs(book data.Book) *CoverUrls {
+	if !book.CoverKey.Valid {
+		return nil
+	}
+
+	url := func(name, format string) string {
+		return "/v1/" + coverBlobKey(book.CoverKey.String, name, format)
+	}
+
+	return &CoverUrls{
+		Original: url("original", book.CoverFormat.String),
+		Small:    url("small", "jpeg"),
+		Medium:   url("medium", "jpeg"),
+		Large:    url("large", "jpeg"),
+	}
+}
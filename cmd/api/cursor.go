@@ -0,0 +1,95 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"strings"
+	"time"
+)
+
+// errInvalidCursor is returned when decoding a cursor that was not created by encode.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// bookCursor marks the last book of a page of the book list, so that the next page
+// can continue right after it. Only the value of the field the list is sorted by is
+// kept, together with the ID of the book that breaks the ties between equal values.
+type bookCursor struct {
+	Sort   string     `json:"s"`
+	ID     uuid.UUID  `json:"id"`
+	Text   *string    `json:"t,omitempty"`
+	Time   *time.Time `json:"tm,omitempty"`
+	Rating *float64   `json:"r,omitempty"`
+	Rank   *float64   `json:"k,omitempty"`
+}
+
+// newBookCursor creates the cursor pointing right after the given book in a list
+// sorted by sort, where rating is the average rating of the book and rank is its
+// relevance to the search.
+func newBookCursor(sort string, book data.Book, rating sql.NullFloat64, rank float64) bookCursor {
+	cursor := bookCursor{Sort: sort, ID: book.ID}
+
+	switch strings.TrimPrefix(sort, "-") {
+	case "name":
+		cursor.Text = &book.Name
+	case "author":
+		cursor.Text = stringPtr(book.Author)
+	case "created_at":
+		cursor.Time = &book.CreatedAt
+	case "updated_at":
+		cursor.Time = &book.UpdatedAt
+	case "rating":
+		if rating.Valid {
+			cursor.Rating = &rating.Float64
+		}
+	case "relevance":
+		cursor.Rank = &rank
+	}
+
+	return cursor
+}
+
+// decodeBookCursor parses a cursor previously returned by encode.
+func decodeBookCursor(s string) (bookCursor, error) {
+	js, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return bookCursor{}, errInvalidCursor
+	}
+
+	var cursor bookCursor
+	if err := json.Unmarshal(js, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return bookCursor{}, errInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// encode returns the cursor in the opaque form handed out to clients.
+func (c bookCursor) encode() string {
+	js, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(js)
+}
+
+// apply sets the position of the cursor on the parameters of the cursor query.
+// Every field is set, so that applying the cursor of the next page clears the
+// values the previous cursor had and the new one does not, such as the rating
+// once paging reaches the books without one.
+func (c bookCursor) apply(params *data.ListBookForUserByCursorParams) {
+	params.CursorID = uuid.NullUUID{UUID: c.ID, Valid: true}
+	params.CursorText = nullString(c.Text)
+	params.CursorTime = sql.NullTime{}
+	if c.Time != nil {
+		params.CursorTime = sql.NullTime{Time: *c.Time, Valid: true}
+	}
+	params.CursorRating = sql.NullFloat64{}
+	if c.Rating != nil {
+		params.CursorRating = sql.NullFloat64{Float64: *c.Rating, Valid: true}
+	}
+	params.CursorRank = sql.NullFloat64{}
+	if c.Rank != nil {
+		params.CursorRank = sql.NullFloat64{Float64: *c.Rank, Valid: true}
+	}
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 )
 
 // logError is a generic helper for logging an error message along with the current request method and URL
@@ -49,6 +51,29 @@ func (app *application) editConflictResponse(w http.ResponseWriter, r *http.Requ
 	app.errorResponse(w, r, http.StatusConflict, errResp)
 }
 
+// preconditionFailedResponse is a helper method for sending a 412 Precondition Failed
+// status code and JSON response to the client when the resource has changed since the
+// version in its If-Match header.
+func (app *application) preconditionFailedResponse(w http.ResponseWriter, r *http.Request) {
+	errResp := Error{Message: "the record has been modified since it was retrieved, please retrieve it and try again"}
+	app.errorResponse(w, r, http.StatusPreconditionFailed, errResp)
+}
+
+// preconditionRequiredResponse is a helper method for sending a 428 Precondition Required
+// status code and JSON response to the client when the If-Match header is missing.
+func (app *application) preconditionRequiredResponse(w http.ResponseWriter, r *http.Request) {
+	errResp := Error{Message: "the If-Match header must be provided with the ETag of the record"}
+	app.errorResponse(w, r, http.StatusPreconditionRequired, errResp)
+}
+
+// unsupportedMediaTypeResponse is a helper method for sending a 415 Unsupported Media Type
+// status code and JSON response to the client when the body is not of one of the media types
+// the endpoint accepts.
+func (app *application) unsupportedMediaTypeResponse(w http.ResponseWriter, r *http.Request, mediaTypes ...string) {
+	errResp := Error{Message: fmt.Sprintf("the Content-Type must be %s", strings.Join(mediaTypes, " or "))}
+	app.errorResponse(w, r, http.StatusUnsupportedMediaType, errResp)
+}
+
 // notFoundResponse is a helper method for sending a 404 Not Found status code
 // and JSON response to the client.
 func (app *application) notFoundResponse(w http.ResponseWriter, r *http.Request) {
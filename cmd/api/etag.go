@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/hayohtee/books/internal/data"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bookETag returns the entity tag of a book, which changes with its version.
+func bookETag(book data.Book) string {
+	return `"` + strconv.Itoa(int(book.Version)) + `"`
+}
+
+// checkIfMatch checks the If-Match header of a request changing a resource
+// against the current entity tag of the resource. It sends the error response
+// and returns false when the header does not match, or when it is missing and
+// the server requires it.
+func (app *application) checkIfMatch(w http.ResponseWriter, r *http.Request, ifMatch *string, etag string) bool {
+	if ifMatch == nil || *ifMatch == "" {
+		if app.cfg.requireIfMatch {
+			app.preconditionRequiredResponse(w, r)
+			return false
+		}
+		return true
+	}
+
+	if !etagMatches(*ifMatch, etag) {
+		app.preconditionFailedResponse(w, r)
+		return false
+	}
+	return true
+}
+
+// etagMatches reports whether the list of entity tags of an If-Match header
+// holds the entity tag. If-Match uses the strong comparison, so a weak entity
+// tag never matches. The entity tag of a representation sent by
+// writeCachedJSON matches the entity tag it was derived from.
+func etagMatches(header, etag string) bool {
+	prefix := strings.TrimSuffix(etag, `"`) + "-"
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag || strings.HasPrefix(candidate, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeCachedJSON is a helper method for sending the JSON response of a GET
+// request the client may cache. The response holds the data of a single user,
+// so only private caches may store it, and they must check it is still fresh
+// before using it.
+//
+// The entity tag of the response is derived from the provided entity tag, when
+// there is one, and the content of the response, so that it changes whenever
+// the response does. When the entity tag, or else the last modification time,
+// shows the client already has the response, a 304 Not Modified is sent instead.
+// The last modification time must cover everything in the response, such as the
+// tags and reviews of a book, which the database records on the book.
+func (app *application) writeCachedJSON(w http.ResponseWriter, r *http.Request, data any, etag string, lastModified time.Time) error {
+	js, err := json.MarshalIndent(data, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	sum := sha256.Sum256(js)
+	hash := hex.EncodeToString(sum[:8])
+	if etag == "" {
+		etag = `"` + hash + `"`
+	} else {
+		etag = strings.TrimSuffix(etag, `"`) + "-" + hash + `"`
+	}
+
+	w.Header().Set("ETag", etag)
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.Header().Add("Vary", "Authorization")
+
+	if notModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(js)
+
+	return nil
+}
+
+// notModified reports whether the conditional headers of a request show that the
+// client already has the response with the entity tag and last modification time.
+// If-Modified-Since is only used without If-None-Match, as the entity tag is the
+// more precise of the two.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		// If-None-Match uses the weak comparison.
+		for _, candidate := range strings.Split(header, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if header := r.Header.Get("If-Modified-Since"); header != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(header)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
@@ -0,0 +1,658 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportPageSize is the number of books loaded at a time while writing an export,
+// which keeps the memory used by an export the same for libraries of any size.
+const exportPageSize = 500
+
+// exportExtensions are the extensions of the files written for each format.
+var exportExtensions = map[ExportFormat]string{
+	Json:      ".json",
+	Csv:       ".zip",
+	Goodreads: ".csv",
+}
+
+// exportContentTypes are the content types of the files written for each format.
+var exportContentTypes = map[ExportFormat]string{
+	Json:      "application/json",
+	Csv:       "application/zip",
+	Goodreads: "text/csv; charset=utf-8",
+}
+
+// exportedBook is a book of the library along with everything attached to it.
+type exportedBook struct {
+	book    data.Book
+	tags    []string
+	shelves []string
+	reviews []data.Review
+	notes   []data.Note
+}
+
+// exportedBookJSON is the representation of a book in a JSON export.
+type exportedBookJSON struct {
+	BookResponse
+	Shelves []string         `json:"shelves"`
+	Reviews []ReviewResponse `json:"reviews"`
+	Notes   []NoteResponse   `json:"notes"`
+}
+
+func (app *application) ListExportHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	rows, err := app.queries.ListExportForUser(r.Context(), userID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	exports := make([]ExportResponse, 0, len(rows))
+	for _, exp := range rows {
+		exports = append(exports, app.newExportResponse(exp))
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, ListExportResponse{Items: exports}, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateExportHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateExportRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	v.Check(validator.PermittedValue(payload.Format, Json, Csv, Goodreads), "format", "must be one of json, csv or goodreads")
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	exp, err := app.queries.CreateExport(r.Context(), data.CreateExportParams{
+		UserID: userID,
+		Format: string(payload.Format),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	app.background(func() {
+		app.runExport(exp)
+	})
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/exports/%s", exp.ID))
+
+	if err := app.writeJSON(w, http.StatusAccepted, app.newExportResponse(exp), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetExportHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	exp, ok := app.getExportForUser(w, r, id)
+	if !ok {
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, app.newExportResponse(exp), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DownloadExportHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	exp, ok := app.getExportForUser(w, r, id)
+	if !ok {
+		return
+	}
+
+	if exp.Status != string(Completed) {
+		app.errorResponse(w, r, http.StatusConflict, Error{Message: "the export is not ready to be downloaded"})
+		return
+	}
+
+	file, err := os.Open(app.exportPath(exp))
+	if err != nil {
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+	defer file.Close()
+
+	format := ExportFormat(exp.Format)
+	name := fmt.Sprintf("books-%s%s", exp.CreatedAt.Format(time.DateOnly), exportExtensions[format])
+
+	w.Header().Set("Content-Type", exportContentTypes[format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+
+	// The file of a large library takes longer to send than the write timeout of
+	// the server allows, so the deadline is lifted for the download.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	http.ServeContent(w, r, name, exp.FinishedAt.Time, file)
+}
+
+// purgeExports deletes the exports that finished longer than the retention period
+// ago, along with their files, once per purge interval until the server shuts
+// down. It does nothing when the exports are kept forever.
+func (app *application) purgeExports() {
+	if app.cfg.export.retention <= 0 || app.cfg.export.purgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(app.cfg.export.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		purged, err := app.queries.PurgeExports(ctx, time.Now().Add(-app.cfg.export.retention))
+		cancel()
+
+		switch {
+		case err != nil:
+			app.logger.Error(fmt.Sprintf("purging exports: %v", err))
+		case len(purged) > 0:
+			for _, exp := range purged {
+				if err := os.Remove(app.exportPath(exp)); err != nil && !errors.Is(err, os.ErrNotExist) {
+					app.logger.Error(fmt.Sprintf("deleting export file: %v", err))
+				}
+			}
+			app.logger.Info(fmt.Sprintf("purged %d exports", len(purged)))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-app.stop:
+			return
+		}
+	}
+}
+
+// getExportForUser fetches the export, sending the error response and returning
+// false when it does not exist or belongs to another user.
+func (app *application) getExportForUser(w http.ResponseWriter, r *http.Request, id uuid.UUID) (data.Export, bool) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return data.Export{}, false
+	}
+
+	exp, err := app.queries.GetExport(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return data.Export{}, false
+	}
+
+	if userID.String() != exp.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return data.Export{}, false
+	}
+
+	return exp, true
+}
+
+// runExport writes the file of the export and records the outcome of the export.
+func (app *application) runExport(exp data.Export) {
+	ctx := context.Background()
+
+	if err := app.queries.StartExport(ctx, exp.ID); err != nil {
+		app.logger.Error(err.Error())
+		return
+	}
+
+	params := data.FinishExportParams{ID: exp.ID, Status: string(Completed)}
+
+	size, err := app.writeExport(ctx, exp)
+	if err != nil {
+		app.logger.Error(err.Error())
+		os.Remove(app.exportPath(exp))
+		params.Status = string(Failed)
+		params.Error = sql.NullString{String: "the server encountered a problem and could not complete the export", Valid: true}
+	} else {
+		params.Size = sql.NullInt64{Int64: size, Valid: true}
+	}
+
+	if err := app.queries.FinishExport(ctx, params); err != nil {
+		app.logger.Error(err.Error())
+	}
+}
+
+// writeExport writes the library of the user to the file of the export in its
+// format, returning the size of the file.
+func (app *application) writeExport(ctx context.Context, exp data.Export) (int64, error) {
+	if err := os.MkdirAll(app.cfg.exportDir, 0o700); err != nil {
+		return 0, err
+	}
+
+	file, err := os.Create(app.exportPath(exp))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+
+	switch ExportFormat(exp.Format) {
+	case Json:
+		err = app.writeJSONExport(ctx, w, exp.UserID)
+	case Csv:
+		err = app.writeCSVExport(ctx, w, exp.UserID)
+	case Goodreads:
+		err = app.writeGoodreadsExport(ctx, w, exp.UserID)
+	default:
+		err = fmt.Errorf("unknown export format %q", exp.Format)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+
+	info, err := os.Stat(file.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// writeJSONExport writes the shelves and the books of the user as a single JSON
+// document. The books are encoded one at a time as they are loaded, instead of
+// building the whole document in memory.
+func (app *application) writeJSONExport(ctx context.Context, w io.Writer, userID uuid.UUID) error {
+	shelves, err := app.queries.ListShelfForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	shelfResponses := make([]ShelfResponse, 0, len(shelves))
+	for _, shelf := range shelves {
+		shelfResponses = append(shelfResponses, newShelfResponse(shelf))
+	}
+
+	enc := json.NewEncoder(w)
+
+	if _, err := io.WriteString(w, `{"exported_at":`); err != nil {
+		return err
+	}
+	if err := enc.Encode(time.Now().UTC()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"shelves":`); err != nil {
+		return err
+	}
+	if err := enc.Encode(shelfResponses); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"books":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err = app.eachExportedBook(ctx, userID, func(book exportedBook) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		resp := exportedBookJSON{
+			BookResponse: newBookResponse(book.book, book.tags),
+			Shelves:      book.shelves,
+			Reviews:      make([]ReviewResponse, 0, len(book.reviews)),
+			Notes:        make([]NoteResponse, 0, len(book.notes)),
+		}
+		for _, review := range book.reviews {
+			resp.Reviews = append(resp.Reviews, newReviewResponse(review))
+		}
+		for _, note := range book.notes {
+			resp.Notes = append(resp.Notes, newNoteResponse(note))
+		}
+
+		return enc.Encode(resp)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
+
+// writeCSVExport writes a zip archive holding a CSV file for each of the shelves,
+// books, reviews and notes of the user. A zip archive is written one file at a
+// time, so the reviews and the notes are kept in temporary files while the books
+// are written, and added to the archive after them.
+func (app *application) writeCSVExport(ctx context.Context, w io.Writer, userID uuid.UUID) error {
+	archive := zip.NewWriter(w)
+
+	shelves, err := app.queries.ListShelfForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	shelvesFile, err := archive.Create("shelves.csv")
+	if err != nil {
+		return err
+	}
+	shelvesCSV := csv.NewWriter(shelvesFile)
+	shelvesCSV.Write([]string{"id", "name", "description", "created_at", "updated_at"})
+	for _, shelf := range shelves {
+		shelvesCSV.Write([]string{
+			shelf.ID.String(),
+			shelf.Name,
+			shelf.Description.String,
+			formatCSVTime(shelf.CreatedAt),
+			formatCSVTime(shelf.UpdatedAt),
+		})
+	}
+	shelvesCSV.Flush()
+	if err := shelvesCSV.Error(); err != nil {
+		return err
+	}
+
+	reviewsFile, err := os.CreateTemp("", "export-reviews-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(reviewsFile.Name())
+	defer reviewsFile.Close()
+
+	notesFile, err := os.CreateTemp("", "export-notes-*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(notesFile.Name())
+	defer notesFile.Close()
+
+	booksFile, err := archive.Create("books.csv")
+	if err != nil {
+		return err
+	}
+
+	booksCSV := csv.NewWriter(booksFile)
+	reviewsCSV := csv.NewWriter(reviewsFile)
+	notesCSV := csv.NewWriter(notesFile)
+
+	booksCSV.Write([]string{
+		"id", "name", "author", "isbn", "publisher", "published_year", "page_count", "language", "genre",
+		"status", "current_page", "progress_percent", "started_at", "finished_at", "created_at", "updated_at",
+		"tags", "shelves",
+	})
+	reviewsCSV.Write([]string{"id", "book_id", "book_name", "rating", "body", "spoiler", "created_at", "updated_at"})
+	notesCSV.Write([]string{
+		"id", "book_id", "book_name", "kind", "body", "page", "chapter", "location_start", "location_end",
+		"created_at", "updated_at",
+	})
+
+	err = app.eachExportedBook(ctx, userID, func(book exportedBook) error {
+		b := book.book
+		booksCSV.Write([]string{
+			b.ID.String(),
+			b.Name,
+			b.Author.String,
+			b.Isbn.String,
+			b.Publisher.String,
+			formatCSVInt(b.PublishedYear),
+			formatCSVInt(b.PageCount),
+			b.Language.String,
+			b.Genre.String,
+			b.Status,
+			formatCSVInt(b.CurrentPage),
+			formatCSVInt(b.ProgressPercent),
+			formatCSVNullTime(b.StartedAt),
+			formatCSVNullTime(b.FinishedAt),
+			formatCSVTime(b.CreatedAt),
+			formatCSVTime(b.UpdatedAt),
+			strings.Join(book.tags, ", "),
+			strings.Join(book.shelves, ", "),
+		})
+
+		for _, review := range book.reviews {
+			reviewsCSV.Write([]string{
+				review.ID.String(),
+				b.ID.String(),
+				b.Name,
+				strconv.FormatFloat(review.Rating, 'f', -1, 64),
+				review.Body.String,
+				strconv.FormatBool(review.Spoiler),
+				formatCSVTime(review.CreatedAt),
+				formatCSVTime(review.UpdatedAt),
+			})
+		}
+
+		for _, note := range book.notes {
+			notesCSV.Write([]string{
+				note.ID.String(),
+				b.ID.String(),
+				b.Name,
+				note.Kind,
+				note.Body,
+				formatCSVInt(note.Page),
+				note.Chapter.String,
+				formatCSVInt(note.LocationStart),
+				formatCSVInt(note.LocationEnd),
+				formatCSVTime(note.CreatedAt),
+				formatCSVTime(note.UpdatedAt),
+			})
+		}
+
+		return booksCSV.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, writer := range []*csv.Writer{booksCSV, reviewsCSV, notesCSV} {
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range []struct {
+		name string
+		*os.File
+	}{{"reviews.csv", reviewsFile}, {"notes.csv", notesFile}} {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		entry, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(entry, file); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// writeGoodreadsExport writes the books of the user as a CSV file with the
+// columns of a Goodreads library export.
+func (app *application) writeGoodreadsExport(ctx context.Context, w io.Writer, userID uuid.UUID) error {
+	writer := csv.NewWriter(w)
+	writer.Write(goodreadsColumns)
+
+	err := app.eachExportedBook(ctx, userID, func(book exportedBook) error {
+		writer.Write(goodreadsRecord(book))
+		return writer.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// eachExportedBook calls fn with each book of the user in the order of their IDs,
+// loading the books a page at a time along with their tags, shelves, reviews and
+// notes.
+func (app *application) eachExportedBook(ctx context.Context, userID uuid.UUID, fn func(book exportedBook) error) error {
+	var afterID uuid.NullUUID
+
+	for {
+		books, err := app.queries.ListBookForExport(ctx, data.ListBookForExportParams{
+			UserID:  userID,
+			AfterID: afterID,
+			Limit:   exportPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(books))
+		exported := make(map[uuid.UUID]*exportedBook, len(books))
+		for _, book := range books {
+			ids = append(ids, book.ID)
+			exported[book.ID] = &exportedBook{book: book, tags: []string{}, shelves: []string{}}
+		}
+
+		tags, err := app.queries.ListTagsForBooks(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			exported[tag.BookID].tags = append(exported[tag.BookID].tags, tag.Name)
+		}
+
+		shelves, err := app.queries.ListShelfNamesForBooks(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, shelf := range shelves {
+			exported[shelf.BookID].shelves = append(exported[shelf.BookID].shelves, shelf.Name)
+		}
+
+		reviews, err := app.queries.ListReviewForBooks(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, review := range reviews {
+			exported[review.BookID].reviews = append(exported[review.BookID].reviews, review)
+		}
+
+		notes, err := app.queries.ListNoteForBooks(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, note := range notes {
+			exported[note.BookID].notes = append(exported[note.BookID].notes, note)
+		}
+
+		for _, id := range ids {
+			if err := fn(*exported[id]); err != nil {
+				return err
+			}
+		}
+
+		if len(books) < exportPageSize {
+			return nil
+		}
+		afterID = uuid.NullUUID{UUID: books[len(books)-1].ID, Valid: true}
+	}
+}
+
+// exportPath returns the path of the file of the export.
+func (app *application) exportPath(exp data.Export) string {
+	return filepath.Join(app.cfg.exportDir, exp.ID.String()+exportExtensions[ExportFormat(exp.Format)])
+}
+
+// formatCSVTime formats a time for a CSV export.
+func formatCSVTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatCSVNullTime formats a time for a CSV export, leaving a missing time empty.
+func formatCSVNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return formatCSVTime(t.Time)
+}
+
+// formatCSVInt formats a number for a CSV export, leaving a missing number empty.
+func formatCSVInt(i sql.NullInt32) string {
+	if !i.Valid {
+		return ""
+	}
+	return strconv.Itoa(int(i.Int32))
+}
+
+// newExportResponse maps an export record to its API representation.
+func (app *application) newExportResponse(exp data.Export) ExportResponse {
+	resp := ExportResponse{
+		Id:         exp.ID,
+		Format:     ExportFormat(exp.Format),
+		Status:     JobStatus(exp.Status),
+		Error:      stringPtr(exp.Error),
+		CreatedAt:  exp.CreatedAt,
+		FinishedAt: timePtr(exp.FinishedAt),
+	}
+
+	if exp.Size.Valid {
+		resp.Size = &exp.Size.Int64
+	}
+	if resp.Status == Completed {
+		url := fmt.Sprintf("/exports/%s/download", exp.ID)
+		resp.DownloadUrl = &url
+	}
+	if exp.FinishedAt.Valid && app.cfg.export.retention > 0 {
+		expiresAt := exp.FinishedAt.Time.Add(app.cfg.export.retention)
+		resp.ExpiresAt = &expiresAt
+	}
+
+	return resp
+}
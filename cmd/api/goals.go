@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxProjectedDays is how far ahead the finish of a goal is projected,
+// a slower pace leaves the projected finish out of the response.
+const maxProjectedDays = 10 * 366
+
+func (app *application) ListGoalHandler(w http.ResponseWriter, r *http.Request, params ListGoalHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var year int
+	if params.Year != nil {
+		year = *params.Year
+		v := validator.New()
+		v.Check(year > 0, "year", "must be greater than zero")
+		if !v.Valid() {
+			app.failedValidationResponse(w, r, v.Errors)
+			return
+		}
+	}
+
+	goals, err := app.queries.ListGoalForUser(r.Context(), data.ListGoalForUserParams{UserID: userID, Year: int32(year)})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	now := time.Now()
+	items := make([]GoalResponse, 0, len(goals))
+	for _, goal := range goals {
+		progress, err := app.goalProgress(r.Context(), goal)
+		if err != nil {
+			app.serverError(w, r, err)
+			return
+		}
+		items = append(items, newGoalResponse(goal, progress, now))
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, ListGoalResponse{Items: items}, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateGoalHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateGoalRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	v.Check(payload.Year > 0, "year", "must be greater than zero")
+	v.Check(payload.Year <= time.Now().Year()+1, "year", "must not be more than a year in the future")
+	v.Check(validator.PermittedValue(payload.Kind, Books, Pages), "kind", "must be either books or pages")
+	validateGoalTarget(payload.Target, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	goal, err := app.queries.CreateGoal(r.Context(), data.CreateGoalParams{
+		UserID: userID,
+		Year:   int32(payload.Year),
+		Kind:   string(payload.Kind),
+		Target: int32(payload.Target),
+	})
+
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "goals_user_id_year_kind_key"):
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: "Goal already exists"})
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	progress, err := app.goalProgress(r.Context(), goal)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if progress >= int64(goal.Target) {
+		app.checkGoals(userID, payload.Year)
+	}
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/goals/%s", goal.ID))
+
+	if err := app.writeJSON(w, http.StatusCreated, newGoalResponse(goal, progress, time.Now()), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetGoalHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	goal, err := app.queries.GetGoal(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != goal.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	progress, err := app.goalProgress(r.Context(), goal)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newGoalResponse(goal, progress, time.Now()), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) UpdateGoalHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload UpdateGoalRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateGoalTarget(payload.Target, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	goal, err := app.queries.GetGoal(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != goal.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	progress, err := app.goalProgress(r.Context(), goal)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	// Raising the target above the current progress reopens a completed goal,
+	// so that reaching the new target is congratulated again.
+	completedAt := goal.CompletedAt
+	if progress < int64(payload.Target) {
+		completedAt = sql.NullTime{}
+	}
+
+	goal, err = app.queries.UpdateGoal(r.Context(), data.UpdateGoalParams{
+		Target:      int32(payload.Target),
+		CompletedAt: completedAt,
+		ID:          goal.ID,
+		Version:     goal.Version,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if progress >= int64(goal.Target) && !goal.CompletedAt.Valid {
+		app.checkGoals(userID, int(goal.Year))
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newGoalResponse(goal, progress, time.Now()), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteGoalHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	goal, err := app.queries.GetGoal(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != goal.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err = app.queries.DeleteGoal(r.Context(), data.DeleteGoalParams{ID: id, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Goal deleted successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// goalProgress returns the number of books finished or pages read
+// by the owner of the goal during the year of the goal.
+func (app *application) goalProgress(ctx context.Context, goal data.Goal) (int64, error) {
+	return app.queries.GetGoalProgress(ctx, data.GetGoalProgressParams{
+		Kind:   goal.Kind,
+		UserID: goal.UserID,
+		Year:   goal.Year,
+	})
+}
+
+// checkGoals looks for goals of the user in the given year whose target has been
+// reached, marks them as completed and sends a congratulation email for each of
+// them. It runs in the background, so it can be called after any change that
+// adds to the progress of a goal without delaying the response.
+func (app *application) checkGoals(userID uuid.UUID, year int) {
+	app.background(func() {
+		ctx := context.Background()
+
+		goals, err := app.queries.ListGoalForUser(ctx, data.ListGoalForUserParams{UserID: userID, Year: int32(year)})
+		if err != nil {
+			app.logger.Error(err.Error())
+			return
+		}
+
+		for _, goal := range goals {
+			if goal.CompletedAt.Valid {
+				continue
+			}
+
+			progress, err := app.goalProgress(ctx, goal)
+			if err != nil {
+				app.logger.Error(err.Error())
+				return
+			}
+			if progress < int64(goal.Target) {
+				continue
+			}
+
+			// Only the request that completes the goal sends the email.
+			completed, err := app.queries.CompleteGoal(ctx, goal.ID)
+			if err != nil {
+				app.logger.Error(err.Error())
+				return
+			}
+			if completed == 0 {
+				continue
+			}
+
+			user, err := app.queries.GetUser(ctx, userID)
+			if err != nil {
+				app.logger.Error(err.Error())
+				return
+			}
+
+			templateData := map[string]any{
+				"FirstName": user.FirstName,
+				"GoalYear":  goal.Year,
+				"Target":    goal.Target,
+				"Kind":      goal.Kind,
+				"Year":      time.Now().Year(),
+			}
+
+			if err = app.mailer.Send(user.Email, "goal_completed.tmpl", templateData); err != nil {
+				app.logger.Error(err.Error())
+			} else {
+				app.logger.Info(fmt.Sprintf("Successfully sent goal completed email to %s", user.Email))
+			}
+		}
+	})
+}
+
+func validateGoalTarget(target int, v *validator.Validator) {
+	v.Check(target > 0, "target", "must be greater than zero")
+	v.Check(target <= 1_000_000, "target", "must not be more than 1000000")
+}
+
+// newGoalResponse maps a goal record and its progress to its API representation.
+// The expected progress assumes the target is spread evenly over the year, and the
+// projections extrapolate the pace of the year so far.
+func newGoalResponse(goal data.Goal, progress int64, now time.Time) GoalResponse {
+	start := time.Date(int(goal.Year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	elapsed := now.Sub(start)
+	fraction := math.Min(math.Max(elapsed.Seconds()/end.Sub(start).Seconds(), 0), 1)
+
+	resp := GoalResponse{
+		Id:               goal.ID,
+		Year:             int(goal.Year),
+		Kind:             GoalKind(goal.Kind),
+		Target:           int(goal.Target),
+		Progress:         int(progress),
+		ProgressPercent:  int(math.Min(float64(progress)*100/float64(goal.Target), 100)),
+		ExpectedProgress: int(float64(goal.Target) * fraction),
+		ProjectedTotal:   int(progress),
+		CompletedAt:      timePtr(goal.CompletedAt),
+		CreatedAt:        goal.CreatedAt,
+		UpdatedAt:        goal.UpdatedAt,
+	}
+
+	switch {
+	case resp.Progress > resp.ExpectedProgress:
+		resp.Schedule = Ahead
+	case resp.Progress < resp.ExpectedProgress:
+		resp.Schedule = Behind
+	default:
+		resp.Schedule = OnTrack
+	}
+
+	switch {
+	case goal.CompletedAt.Valid:
+		resp.ProjectedFinish = &openapitypes.Date{Time: goal.CompletedAt.Time}
+	case progress > 0 && fraction > 0:
+		if fraction < 1 {
+			resp.ProjectedTotal = int(float64(progress) / fraction)
+		}
+		perDay := float64(progress) / math.Min(elapsed.Hours()/24, end.Sub(start).Hours()/24)
+		if days := float64(goal.Target) / perDay; days <= maxProjectedDays {
+			resp.ProjectedFinish = &openapitypes.Date{Time: start.AddDate(0, 0, int(days))}
+		}
+	}
+
+	return resp
+}
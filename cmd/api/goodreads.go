@@ -0,0 +1,311 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/validator"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errNotGoodreadsExport is returned when a file lacks the columns of a Goodreads library export.
+var errNotGoodreadsExport = errors.New("not a goodreads library export")
+
+// goodreadsStatuses maps the exclusive shelves of Goodreads to reading statuses,
+// the books on any other exclusive shelf are imported as want to read.
+var goodreadsStatuses = map[string]ReadingStatus{
+	"to-read":           WantToRead,
+	"currently-reading": Reading,
+	"read":              Finished,
+	"did-not-finish":    Abandoned,
+	"dnf":               Abandoned,
+	"abandoned":         Abandoned,
+}
+
+// goodreadsExclusiveShelves maps the reading statuses to the exclusive shelves of
+// Goodreads, the reverse of goodreadsStatuses.
+var goodreadsExclusiveShelves = map[ReadingStatus]string{
+	WantToRead: "to-read",
+	Reading:    "currently-reading",
+	Finished:   "read",
+	Abandoned:  "did-not-finish",
+}
+
+// goodreadsColumns are the columns of a Goodreads library export.
+var goodreadsColumns = []string{
+	"Book Id", "Title", "Author", "Author l-f", "Additional Authors", "ISBN", "ISBN13", "My Rating",
+	"Average Rating", "Publisher", "Binding", "Number of Pages", "Year Published", "Original Publication Year",
+	"Date Read", "Date Added", "Bookshelves", "Bookshelves with positions", "Exclusive Shelf", "My Review",
+	"Spoiler", "Private Notes", "Read Count", "Owned Copies",
+}
+
+// goodreadsDateLayouts are the layouts of the dates in a Goodreads library export.
+var goodreadsDateLayouts = []string{"2006/01/02", "2006-01-02"}
+
+func (app *application) ImportGoodreadsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	file, err := app.readImportFile(w, r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	books, failed, err := parseGoodreadsCSV(file)
+	if err != nil {
+		v := validator.New()
+		v.AddError("file", "must be a Goodreads library export")
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	app.sendImport(w, r, userID, "goodreads", books, failed)
+}
+
+// parseGoodreadsCSV reads the books of a Goodreads library export. The rows that
+// can not be read are returned as failed instead of stopping the whole file.
+func parseGoodreadsCSV(r io.Reader) ([]importedBook, []ImportIssue, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, errNotGoodreadsExport
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range []string{"Title", "Author", "Exclusive Shelf"} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, errNotGoodreadsExport
+		}
+	}
+
+	var books []importedBook
+	var failed []ImportIssue
+
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			failed = append(failed, ImportIssue{Row: row, Reason: "could not be read"})
+			continue
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		book, err := goodreadsBook(row, field)
+		if err != nil {
+			failed = append(failed, ImportIssue{Row: row, Reason: err.Error()})
+			continue
+		}
+		books = append(books, book)
+	}
+
+	return books, failed, nil
+}
+
+// goodreadsBook reads a book from the fields of a row of a Goodreads library export.
+func goodreadsBook(row int, field func(name string) string) (importedBook, error) {
+	book := importedBook{
+		row:       row,
+		name:      field("Title"),
+		author:    optionalField(field("Author")),
+		publisher: optionalField(field("Publisher")),
+		status:    WantToRead,
+		spoiler:   field("Spoiler") == "true",
+	}
+
+	// Goodreads writes ISBNs as ="0439023483" to keep spreadsheets from
+	// turning them into numbers, and ISBNs it does not know are left out.
+	for _, name := range []string{"ISBN13", "ISBN"} {
+		isbn := strings.Trim(field(name), `="`)
+		if isbn != "" && validator.ValidISBN(isbn) {
+			book.isbn = canonicalISBN(&isbn)
+			break
+		}
+	}
+
+	var err error
+	if book.pageCount, err = optionalIntField(field("Number of Pages")); err != nil {
+		return importedBook{}, errors.New("page_count: must be a number")
+	}
+	if book.publishedYear, err = optionalIntField(field("Year Published")); err != nil {
+		return importedBook{}, errors.New("published_year: must be a number")
+	}
+	if book.publishedYear == nil {
+		if book.publishedYear, err = optionalIntField(field("Original Publication Year")); err != nil {
+			return importedBook{}, errors.New("published_year: must be a number")
+		}
+	}
+	// Goodreads writes zero for the page counts and years it does not know.
+	if book.pageCount != nil && *book.pageCount == 0 {
+		book.pageCount = nil
+	}
+	if book.publishedYear != nil && *book.publishedYear == 0 {
+		book.publishedYear = nil
+	}
+
+	exclusiveShelf := field("Exclusive Shelf")
+	if status, ok := goodreadsStatuses[exclusiveShelf]; ok {
+		book.status = status
+	}
+
+	if value := field("Date Read"); value != "" && (book.status == Finished || book.status == Abandoned) {
+		dateRead, err := parseGoodreadsDate(value)
+		if err != nil {
+			return importedBook{}, errors.New("date_read: must be a date")
+		}
+		book.finishedAt = sql.NullTime{Time: dateRead, Valid: true}
+	}
+
+	rating, err := optionalIntField(field("My Rating"))
+	if err != nil {
+		return importedBook{}, errors.New("rating: must be a number")
+	}
+	// Goodreads uses zero for books that were not rated, and a review
+	// without a rating has nowhere to go.
+	if rating != nil && *rating > 0 {
+		value := float64(*rating)
+		book.rating = &value
+		book.review = optionalField(field("My Review"))
+	}
+
+	for _, shelf := range strings.Split(field("Bookshelves"), ",") {
+		shelf = strings.TrimSpace(shelf)
+		if _, exclusive := goodreadsStatuses[shelf]; shelf == "" || exclusive || shelf == exclusiveShelf {
+			continue
+		}
+		if !slices.Contains(book.shelves, shelf) {
+			book.shelves = append(book.shelves, shelf)
+		}
+	}
+
+	return book, nil
+}
+
+// parseGoodreadsDate parses a date of a Goodreads library export.
+func parseGoodreadsDate(value string) (time.Time, error) {
+	var err error
+	for _, layout := range goodreadsDateLayouts {
+		var date time.Time
+		if date, err = time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// optionalField returns nil for an empty field.
+func optionalField(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// optionalIntField parses a numeric field, returning nil for an empty field.
+func optionalIntField(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
+
+// goodreadsRecord returns the row of a Goodreads library export for the book. The
+// most recent review of the book is used for its rating, rounded to the whole
+// stars Goodreads rates books with, and the columns without an equivalent in
+// this API are left empty.
+func goodreadsRecord(book exportedBook) []string {
+	b := book.book
+	exclusiveShelf := goodreadsExclusiveShelves[ReadingStatus(b.Status)]
+
+	rating := "0"
+	var review, spoiler string
+	if len(book.reviews) > 0 {
+		latest := book.reviews[len(book.reviews)-1]
+		rating = strconv.Itoa(int(math.Round(latest.Rating)))
+		review = latest.Body.String
+		if latest.Spoiler {
+			spoiler = "true"
+		}
+	}
+
+	// ISBNs are stored as ISBN-13, so the ISBN column is only filled for the
+	// ISBN-13s with the 978 prefix that have an ISBN-10 equivalent.
+	var isbn10 string
+	if b.Isbn.Valid {
+		isbn10, _ = validator.ISBN13To10(b.Isbn.String)
+	}
+
+	var dateRead string
+	readCount := "0"
+	if ReadingStatus(b.Status) == Finished {
+		readCount = "1"
+		if b.FinishedAt.Valid {
+			dateRead = b.FinishedAt.Time.Format(goodreadsDateLayouts[0])
+		}
+	}
+
+	var year string
+	if b.PublishedYear.Valid {
+		year = strconv.Itoa(int(b.PublishedYear.Int32))
+	}
+
+	return []string{
+		"",
+		b.Name,
+		b.Author.String,
+		"",
+		"",
+		// Goodreads wraps ISBNs in a formula to keep spreadsheets from
+		// turning them into numbers.
+		fmt.Sprintf(`="%s"`, isbn10),
+		fmt.Sprintf(`="%s"`, b.Isbn.String),
+		rating,
+		"",
+		b.Publisher.String,
+		"",
+		formatCSVInt(b.PageCount),
+		year,
+		year,
+		dateRead,
+		b.CreatedAt.Format(goodreadsDateLayouts[0]),
+		strings.Join(append(slices.Clone(book.shelves), exclusiveShelf), ", "),
+		"",
+		exclusiveShelf,
+		review,
+		spoiler,
+		"",
+		readCount,
+		"0",
+	}
+}
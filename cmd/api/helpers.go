@@ -0,0 +1,52 @@
+package main
+
+import (
+	"database/sql"
+	"time"
+)
+
+// nullString converts an optional string into a sql.NullString, treating
+// a nil pointer as SQL NULL.
+func nullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+// nullInt32 converts an optional int into a sql.NullInt32, treating
+// a nil pointer as SQL NULL.
+func nullInt32(i *int) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*i), Valid: true}
+}
+
+// stringPtr converts a sql.NullString into an optional string, returning
+// nil when the value is SQL NULL.
+func stringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// intPtr converts a sql.NullInt32 into an optional int, returning
+// nil when the value is SQL NULL.
+func intPtr(i sql.NullInt32) *int {
+	if !i.Valid {
+		return nil
+	}
+	value := int(i.Int32)
+	return &value
+}
+
+// timePtr converts a sql.NullTime into an optional time.Time, returning
+// nil when the value is SQL NULL.
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+)
+
+// bookSnapshot holds the fields of a book a revision can restore, read from the
+// snapshot of the book the revision recorded.
+type bookSnapshot struct {
+	Name          string  `json:"name"`
+	Author        *string `json:"author"`
+	Isbn          *string `json:"isbn"`
+	Publisher     *string `json:"publisher"`
+	PublishedYear *int    `json:"published_year"`
+	PageCount     *int    `json:"page_count"`
+	Language      *string `json:"language"`
+	Genre         *string `json:"genre"`
+}
+
+func (app *application) ListBookHistoryHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListBookHistoryHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	var page = 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	var pageSize = 10
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	rows, err := app.queries.ListBookRevisionForBook(r.Context(), data.ListBookRevisionForBookParams{
+		BookID: book.ID,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	var totalRecords int64
+	revisions := make([]BookRevisionResponse, 0, len(rows))
+	for _, value := range rows {
+		totalRecords = value.TotalRecords
+		revision, err := newBookRevisionResponse(value.BookRevision)
+		if err != nil {
+			app.serverError(w, r, err)
+			return
+		}
+		revisions = append(revisions, revision)
+	}
+
+	resp := ListBookRevisionResponse{
+		Metadata: calculateMetadata(int(totalRecords), page, pageSize),
+		Items:    revisions,
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) RestoreBookRevisionHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, revisionId openapitypes.UUID, params RestoreBookRevisionHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload RestoreBookRevisionRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	v.Check(payload.Version > 0, "version", "must be greater than zero")
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	revision, err := app.queries.GetBookRevision(r.Context(), revisionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if revision.BookID != book.ID {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	var snapshot bookSnapshot
+	if err := json.Unmarshal(revision.Snapshot, &snapshot); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	// The version the client read is checked by the update itself, in the same
+	// way as the version of the book when it is updated directly.
+	book, err = app.queries.UpdateBook(r.Context(), data.UpdateBookParams{
+		Name:          snapshot.Name,
+		Author:        nullString(snapshot.Author),
+		Isbn:          nullString(snapshot.Isbn),
+		Publisher:     nullString(snapshot.Publisher),
+		PublishedYear: nullInt32(snapshot.PublishedYear),
+		PageCount:     nullInt32(snapshot.PageCount),
+		Language:      nullString(snapshot.Language),
+		Genre:         nullString(snapshot.Genre),
+		ID:            book.ID,
+		Version:       int32(payload.Version),
+		UserID:        userID,
+	})
+
+	if err != nil {
+		message, conflict := bookConflictMessage(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		case conflict:
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: message})
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	// The goals are checked after every change to a finished book, in the same way
+	// as when it is created or its progress is updated.
+	if book.FinishedAt.Valid {
+		app.checkGoals(userID, book.FinishedAt.Time.Year())
+	}
+
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("ETag", bookETag(book))
+
+	if err := app.writeJSON(w, http.StatusOK, resp, header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// newBookRevisionResponse maps a book revision record to its API representation.
+func newBookRevisionResponse(revision data.BookRevision) (BookRevisionResponse, error) {
+	changes := make(map[string]FieldChange)
+	if err := json.Unmarshal(revision.Changes, &changes); err != nil {
+		return BookRevisionResponse{}, err
+	}
+
+	return BookRevisionResponse{
+		Id:        revision.ID,
+		Version:   int(revision.Version),
+		Action:    RevisionAction(revision.Action),
+		UserId:    revision.UserID,
+		Changes:   changes,
+		CreatedAt: revision.CreatedAt,
+	}, nil
+}
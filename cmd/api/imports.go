@@ -0,0 +1,492 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxImportSize is the largest file accepted by the import endpoints.
+const maxImportSize = 10 << 20
+
+// maxImportRows is the largest number of books imported from a single file.
+const maxImportRows = 10_000
+
+// importedBook is a book read from the export of another application,
+// along with the number of the row of the file it was read from.
+type importedBook struct {
+	row           int
+	name          string
+	author        *string
+	isbn          *string
+	publisher     *string
+	publishedYear *int
+	pageCount     *int
+	language      *string
+	status        ReadingStatus
+	startedAt     sql.NullTime
+	finishedAt    sql.NullTime
+	rating        *float64
+	review        *string
+	spoiler       bool
+	tags          []string
+	shelves       []string
+	note          *importedNote
+}
+
+// importedNote is a note read along with the book it belongs to, such as a
+// highlight made on an e-reader.
+type importedNote struct {
+	kind          NoteKind
+	body          string
+	page          *int
+	locationStart *int
+	locationEnd   *int
+	createdAt     time.Time
+}
+
+// importResult holds the outcome of the rows of an import.
+type importResult struct {
+	imported int
+	skipped  []ImportIssue
+	failed   []ImportIssue
+}
+
+func (app *application) GetImportHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	imp, err := app.queries.GetImport(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != imp.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	resp, err := newImportResponse(imp)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// readImportFile returns the file uploaded in the file field of a multipart form,
+// with an error describing the problem when there is no such file.
+func (app *application) readImportFile(w http.ResponseWriter, r *http.Request) (multipart.File, error) {
+	extendUploadDeadlines(w, maxImportSize)
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesError):
+			return nil, fmt.Errorf("file must not be larger than %dMB", maxImportSize>>20)
+		default:
+			return nil, errors.New("body must be a multipart form with the export in the file field")
+		}
+	}
+
+	return file, nil
+}
+
+// sendImport records an import of the given books, starts it in the background
+// and sends the import to the client. The rows that failed while reading the
+// file are reported together with the rows that fail to import.
+func (app *application) sendImport(w http.ResponseWriter, r *http.Request, userID uuid.UUID, source string, books []importedBook, failed []ImportIssue) {
+	total := len(books) + len(failed)
+
+	v := validator.New()
+	v.Check(total > 0, "file", "must contain at least one book")
+	v.Check(total <= maxImportRows, "file", fmt.Sprintf("must not contain more than %d books", maxImportRows))
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	valid := make([]importedBook, 0, len(books))
+	for _, book := range books {
+		if reason := book.validate(); reason != "" {
+			failed = append(failed, ImportIssue{Row: book.row, Reason: reason})
+			continue
+		}
+		valid = append(valid, book)
+	}
+
+	failedJSON, err := marshalImportIssues(failed)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	imp, err := app.queries.CreateImport(r.Context(), data.CreateImportParams{
+		UserID:    userID,
+		Source:    source,
+		RowsTotal: int32(total),
+		Failed:    failedJSON,
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	app.background(func() {
+		app.runImport(imp, valid, failed)
+	})
+
+	resp, err := newImportResponse(imp)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/imports/%s", imp.ID))
+
+	if err := app.writeJSON(w, http.StatusAccepted, resp, header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// runImport imports the books and records the outcome of the import. When the
+// import fails, nothing is imported and only the rows that could not be read
+// are reported.
+func (app *application) runImport(imp data.Import, books []importedBook, failed []ImportIssue) {
+	ctx := context.Background()
+
+	if err := app.queries.StartImport(ctx, imp.ID); err != nil {
+		app.logger.Error(err.Error())
+		return
+	}
+
+	params := data.FinishImportParams{ID: imp.ID, Status: string(Completed)}
+
+	result, err := app.importBooks(ctx, imp.UserID, books)
+	if err != nil {
+		app.logger.Error(err.Error())
+		params.Status = string(Failed)
+		params.Error = sql.NullString{String: "the server encountered a problem and could not complete the import", Valid: true}
+		result = importResult{}
+	}
+
+	params.RowsImported = int32(result.imported)
+	if params.Skipped, err = marshalImportIssues(result.skipped); err != nil {
+		app.logger.Error(err.Error())
+		return
+	}
+	if params.Failed, err = marshalImportIssues(append(failed, result.failed...)); err != nil {
+		app.logger.Error(err.Error())
+		return
+	}
+
+	if err := app.queries.FinishImport(ctx, params); err != nil {
+		app.logger.Error(err.Error())
+		return
+	}
+
+	if params.Status == string(Completed) {
+		years := make(map[int]bool)
+		for _, book := range books {
+			if book.status == Finished && book.finishedAt.Valid {
+				years[book.finishedAt.Time.Year()] = true
+			}
+		}
+		for year := range years {
+			app.checkGoals(imp.UserID, year)
+		}
+	}
+}
+
+// importBooks imports the books in a single transaction, so that an unexpected
+// error leaves the library as it was. Each book is imported under a savepoint,
+// so that a book rejected by the database only fails its own row.
+func (app *application) importBooks(ctx context.Context, userID uuid.UUID, books []importedBook) (importResult, error) {
+	var result importResult
+
+	tx, err := app.db.BeginTx(ctx, nil)
+	if err != nil {
+		return importResult{}, err
+	}
+	defer tx.Rollback()
+
+	qtx := app.queries.WithTx(tx)
+
+	for _, book := range books {
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT import_book"); err != nil {
+			return importResult{}, err
+		}
+
+		changed, err := importBook(ctx, qtx, userID, book)
+		if err != nil {
+			app.logger.Error(fmt.Sprintf("importing row %d: %v", book.row, err))
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_book"); err != nil {
+				return importResult{}, err
+			}
+			result.failed = append(result.failed, ImportIssue{Row: book.row, Reason: "could not be saved"})
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT import_book"); err != nil {
+			return importResult{}, err
+		}
+
+		if changed {
+			result.imported++
+		} else {
+			result.skipped = append(result.skipped, ImportIssue{Row: book.row, Reason: "already in the library"})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return importResult{}, err
+	}
+
+	return result, nil
+}
+
+// importBook creates the book, or merges it into the book of the user with the
+// same ISBN or name by filling in the details the existing book is missing. It
+// reports whether the library changed, adding tags and shelves to a book does
+// not count as a change. The note of the book is added unless the book already
+// has the same note.
+func importBook(ctx context.Context, q *data.Queries, userID uuid.UUID, book importedBook) (bool, error) {
+	existing, err := findImportedBook(ctx, q, userID, book)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	var changed bool
+	if errors.Is(err, sql.ErrNoRows) {
+		existing, err = q.CreateBook(ctx, data.CreateBookParams{
+			UserID:        userID,
+			Name:          book.name,
+			Author:        nullString(book.author),
+			Isbn:          nullString(book.isbn),
+			Publisher:     nullString(book.publisher),
+			PublishedYear: nullInt32(book.publishedYear),
+			PageCount:     nullInt32(book.pageCount),
+			Language:      nullString(book.language),
+			Status:        string(book.status),
+			StartedAt:     book.startedAt,
+			FinishedAt:    book.finishedAt,
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	} else {
+		author, filledAuthor := fillString(existing.Author, book.author)
+		isbn, filledIsbn := fillString(existing.Isbn, book.isbn)
+		publisher, filledPublisher := fillString(existing.Publisher, book.publisher)
+		publishedYear, filledPublishedYear := fillInt32(existing.PublishedYear, book.publishedYear)
+		pageCount, filledPageCount := fillInt32(existing.PageCount, book.pageCount)
+		language, filledLanguage := fillString(existing.Language, book.language)
+
+		if filledAuthor || filledIsbn || filledPublisher || filledPublishedYear || filledPageCount || filledLanguage {
+			existing, err = q.UpdateBook(ctx, data.UpdateBookParams{
+				Name:          existing.Name,
+				Author:        author,
+				Isbn:          isbn,
+				Publisher:     publisher,
+				PublishedYear: publishedYear,
+				PageCount:     pageCount,
+				Language:      language,
+				Genre:         existing.Genre,
+				ID:            existing.ID,
+				Version:       existing.Version,
+				UserID:        userID,
+			})
+			if err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+
+	if book.rating != nil {
+		reviews, err := q.CountReviewForBook(ctx, existing.ID)
+		if err != nil {
+			return false, err
+		}
+		if reviews == 0 {
+			_, err = q.CreateReview(ctx, data.CreateReviewParams{
+				BookID:  existing.ID,
+				UserID:  userID,
+				Rating:  *book.rating,
+				Body:    nullString(book.review),
+				Spoiler: book.spoiler,
+			})
+			if err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+
+	if len(book.tags) > 0 {
+		err := q.AddTagsToBook(ctx, data.AddTagsToBookParams{UserID: userID, Names: book.tags, BookID: existing.ID})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for _, name := range book.shelves {
+		shelf, err := q.GetShelfForUserByName(ctx, data.GetShelfForUserByNameParams{UserID: userID, Name: name})
+		if errors.Is(err, sql.ErrNoRows) {
+			shelf, err = q.CreateShelf(ctx, data.CreateShelfParams{UserID: userID, Name: name})
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if err := q.AddBookToShelf(ctx, data.AddBookToShelfParams{ShelfID: shelf.ID, BookID: existing.ID}); err != nil {
+			return false, err
+		}
+	}
+
+	if book.note != nil {
+		rows, err := q.ImportNote(ctx, data.ImportNoteParams{
+			BookID:        existing.ID,
+			UserID:        userID,
+			Kind:          string(book.note.kind),
+			Body:          book.note.body,
+			Page:          nullInt32(book.note.page),
+			LocationStart: nullInt32(book.note.locationStart),
+			LocationEnd:   nullInt32(book.note.locationEnd),
+			CreatedAt:     book.note.createdAt,
+		})
+		if err != nil {
+			return false, err
+		}
+		if rows > 0 {
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// findImportedBook looks up the book of the user with the ISBN of the imported
+// book, falling back to the book with the same name.
+func findImportedBook(ctx context.Context, q *data.Queries, userID uuid.UUID, book importedBook) (data.Book, error) {
+	if book.isbn != nil {
+		existing, err := q.GetBookForUserByIsbn(ctx, data.GetBookForUserByIsbnParams{UserID: userID, Isbn: nullString(book.isbn)})
+		if !errors.Is(err, sql.ErrNoRows) {
+			return existing, err
+		}
+	}
+
+	return q.GetBookForUserByName(ctx, data.GetBookForUserByNameParams{UserID: userID, Name: book.name})
+}
+
+// validate checks the book with the rules of the book, review and shelf endpoints,
+// returning why its row fails or an empty string when the book is valid.
+func (b importedBook) validate() string {
+	v := validator.New()
+	validateBookName(b.name, v)
+	validateBookMetadata(b.author, b.isbn, b.publisher, b.publishedYear, b.pageCount, b.language, nil, v)
+	if b.rating != nil {
+		validateReview(*b.rating, b.review, v)
+	}
+	validateTags(b.tags, v)
+	if b.note != nil {
+		validateNote(&b.note.kind, b.note.body, b.note.page, nil, b.note.locationStart, b.note.locationEnd, v)
+	}
+	for _, shelf := range b.shelves {
+		v.Check(len(shelf) <= 200, "shelves", "must not be more than 200 bytes")
+	}
+
+	if v.Valid() {
+		return ""
+	}
+
+	reasons := make([]string, 0, len(v.Errors))
+	for key, message := range v.Errors {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", key, message))
+	}
+	sort.Strings(reasons)
+
+	return strings.Join(reasons, "; ")
+}
+
+// fillString returns the imported value when the current value is missing,
+// and whether it did so.
+func fillString(current sql.NullString, imported *string) (sql.NullString, bool) {
+	if current.Valid || imported == nil {
+		return current, false
+	}
+	return nullString(imported), true
+}
+
+// fillInt32 returns the imported value when the current value is missing,
+// and whether it did so.
+func fillInt32(current sql.NullInt32, imported *int) (sql.NullInt32, bool) {
+	if current.Valid || imported == nil {
+		return current, false
+	}
+	return nullInt32(imported), true
+}
+
+// marshalImportIssues encodes the issues of an import for storage in the order
+// of their rows, encoding no issues as an empty list.
+func marshalImportIssues(issues []ImportIssue) (json.RawMessage, error) {
+	if issues == nil {
+		issues = []ImportIssue{}
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Row < issues[j].Row
+	})
+	return json.Marshal(issues)
+}
+
+// newImportResponse maps an import record to its API representation.
+func newImportResponse(imp data.Import) (ImportResponse, error) {
+	resp := ImportResponse{
+		Id:           imp.ID,
+		Source:       imp.Source,
+		Status:       JobStatus(imp.Status),
+		RowsTotal:    int(imp.RowsTotal),
+		RowsImported: int(imp.RowsImported),
+		Error:        stringPtr(imp.Error),
+		CreatedAt:    imp.CreatedAt,
+		FinishedAt:   timePtr(imp.FinishedAt),
+	}
+
+	if err := json.Unmarshal(imp.Skipped, &resp.Skipped); err != nil {
+		return ImportResponse{}, err
+	}
+	if err := json.Unmarshal(imp.Failed, &resp.Failed); err != nil {
+		return ImportResponse{}, err
+	}
+	resp.RowsSkipped = len(resp.Skipped)
+	resp.RowsFailed = len(resp.Failed)
+
+	return resp, nil
+}
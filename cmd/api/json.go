@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"strings"
 )
@@ -21,6 +22,10 @@ func (app *application) writeJSON(w http.ResponseWriter, status int, data any, h
 		return err
 	}
 
+	// Responses hold the data of a user, so they must not be stored
+	// by caches unless the header provides another policy.
+	w.Header().Set("Cache-Control", "no-store")
+
 	// Include the header values in the response
 	for key, values := range header {
 		w.Header()[key] = values
@@ -38,14 +43,38 @@ func (app *application) writeJSON(w http.ResponseWriter, status int, data any, h
 // readJSON is a helper method for reading JSON body into the specified
 // destination. It also triages all possible errors and return a custom
 // helpful error.
+//
+// When the body is a JSON Merge Patch or a JSON Patch, as told by its
+// Content-Type, the patch is applied to the current value of the destination.
 func (app *application) readJSON(w http.ResponseWriter, r *http.Request, dst any) error {
 	// Use the http.MaxBytesReader() to limit the size of the request body to 1MB.
 	maxBytes := 1_048_576
 	r.Body = http.MaxBytesReader(w, r.Body, int64(maxBytes))
 
+	switch mediaType := requestMediaType(r); mediaType {
+	case mergePatchMediaType, jsonPatchMediaType:
+		return readPatch(r.Body, mediaType, dst)
+	}
+
 	dec := json.NewDecoder(r.Body)
 	dec.DisallowUnknownFields()
 
+	return decodeJSON(dec, dst)
+}
+
+// requestMediaType returns the media type of the body of the request, or an
+// empty string when the Content-Type header is missing or malformed.
+func requestMediaType(r *http.Request) string {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// decodeJSON decodes the single JSON value of the decoder into the destination,
+// turning the errors into ones that can be shown to the client.
+func decodeJSON(dec *json.Decoder, dst any) error {
 	// Decode the request body to the destination while providing helpful error messages if any.
 	if err := dec.Decode(dst); err != nil {
 		var syntaxError *json.SyntaxError
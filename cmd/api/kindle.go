@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/validator"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errNotKindleClippings is returned when a file is not the clippings file of a Kindle.
+var errNotKindleClippings = errors.New("not a kindle clippings file")
+
+// kindleSeparator is the line ending each clipping of a clippings file.
+const kindleSeparator = "=========="
+
+// kindleClippingLimit is the text a Kindle saves in place of the highlight once
+// the publisher's limit of clippings for a book has been reached.
+const kindleClippingLimit = "<You have reached the clipping limit for this item>"
+
+var (
+	kindleKindRX     = regexp.MustCompile(`(?i)^-\s*(?:your\s+)?(highlight|note|bookmark)\b`)
+	kindlePageRX     = regexp.MustCompile(`(?i)\bpage\s+(\d+)`)
+	kindleLocationRX = regexp.MustCompile(`(?i)\b(?:location|loc\.)\s*(\d+)(?:-(\d+))?`)
+	kindleAddedRX    = regexp.MustCompile(`(?i)\badded on\s+(.+)$`)
+)
+
+// kindleKinds maps the kinds of clippings to the kinds of notes.
+var kindleKinds = map[string]NoteKind{
+	"highlight": Highlight,
+	"note":      Note,
+	"bookmark":  Bookmark,
+}
+
+// kindleDateLayouts are the layouts of the dates of a clippings file, which
+// differ between the regions and the generations of the Kindle.
+var kindleDateLayouts = []string{
+	"Monday, January 2, 2006 3:04:05 PM",
+	"Monday, 2 January 2006 15:04:05",
+	"Monday, January 2, 2006, 03:04 PM",
+}
+
+func (app *application) ImportKindleHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	file, err := app.readImportFile(w, r)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+	defer file.Close()
+
+	books, failed, err := parseKindleClippings(file)
+	if err != nil {
+		v := validator.New()
+		v.AddError("file", "must be the My Clippings.txt file of a Kindle")
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	app.sendImport(w, r, userID, "kindle", books, failed)
+}
+
+// parseKindleClippings reads the clippings of a Kindle clippings file, each as
+// a book holding the clipping as its note. The line a clipping starts on is used
+// as its row, and the clippings that can not be read are returned as failed
+// instead of stopping the whole file.
+func parseKindleClippings(r io.Reader) ([]importedBook, []ImportIssue, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportSize)
+
+	var books []importedBook
+	var failed []ImportIssue
+
+	var lines []string
+	var separators int
+	row := 1
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		// A Kindle starts every clipping, and not only the file, with a BOM.
+		text = strings.TrimPrefix(text, "\ufeff")
+
+		if strings.TrimSpace(text) != kindleSeparator {
+			if len(lines) == 0 && strings.TrimSpace(text) == "" {
+				row = line + 1
+				continue
+			}
+			lines = append(lines, text)
+			continue
+		}
+
+		separators++
+		book, err := kindleBook(row, lines)
+		if err != nil {
+			failed = append(failed, ImportIssue{Row: row, Reason: err.Error()})
+		} else {
+			books = append(books, book)
+		}
+		lines = nil
+		row = line + 1
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if separators == 0 {
+		return nil, nil, errNotKindleClippings
+	}
+
+	return books, failed, nil
+}
+
+// kindleBook reads a clipping from its lines, which are the title of the book,
+// the description of the clipping, an empty line and the text of the clipping.
+func kindleBook(row int, lines []string) (importedBook, error) {
+	if len(lines) < 2 {
+		return importedBook{}, errors.New("could not be read")
+	}
+
+	name, author := splitKindleTitle(strings.TrimSpace(lines[0]))
+	description := strings.TrimSpace(lines[1])
+
+	kind := kindleKindRX.FindStringSubmatch(description)
+	if kind == nil {
+		return importedBook{}, errors.New("kind: must be a highlight, note or bookmark")
+	}
+
+	added := kindleAddedRX.FindStringSubmatch(description)
+	if added == nil {
+		return importedBook{}, errors.New("added_on: must be provided")
+	}
+	createdAt, err := parseKindleDate(strings.TrimSpace(added[1]))
+	if err != nil {
+		return importedBook{}, errors.New("added_on: must be a date")
+	}
+
+	note := importedNote{
+		kind:      kindleKinds[strings.ToLower(kind[1])],
+		body:      strings.TrimSpace(strings.Join(lines[2:], "\n")),
+		createdAt: createdAt,
+	}
+	if note.body == kindleClippingLimit {
+		return importedBook{}, errors.New("body: was not saved because of the clipping limit of the book")
+	}
+
+	if page := kindlePageRX.FindStringSubmatch(description); page != nil {
+		note.page = atoiPtr(page[1])
+	}
+	if location := kindleLocationRX.FindStringSubmatch(description); location != nil {
+		note.locationStart = atoiPtr(location[1])
+		if location[2] != "" {
+			note.locationEnd = atoiPtr(kindleLocationEnd(location[1], location[2]))
+		}
+	}
+
+	return importedBook{
+		row:       row,
+		name:      name,
+		author:    author,
+		status:    Reading,
+		startedAt: sql.NullTime{Time: createdAt, Valid: true},
+		note:      &note,
+	}, nil
+}
+
+// splitKindleTitle splits the title line of a clipping into the name of the book
+// and the author the Kindle writes in parentheses after it, taking care of the
+// names that have parentheses of their own.
+func splitKindleTitle(title string) (string, *string) {
+	if !strings.HasSuffix(title, ")") {
+		return title, nil
+	}
+
+	depth := 0
+	for i := len(title) - 1; i >= 0; i-- {
+		switch title[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				name := strings.TrimSpace(title[:i])
+				if name == "" {
+					return title, nil
+				}
+				return name, optionalField(strings.TrimSpace(title[i+1 : len(title)-1]))
+			}
+		}
+	}
+
+	return title, nil
+}
+
+// kindleLocationEnd returns the end of a location range, which older Kindles
+// shorten to the digits that differ from its start, such as 1170-72.
+func kindleLocationEnd(start, end string) string {
+	if len(end) < len(start) {
+		return start[:len(start)-len(end)] + end
+	}
+	return end
+}
+
+// parseKindleDate parses the date a clipping was added on.
+func parseKindleDate(value string) (time.Time, error) {
+	var err error
+	for _, layout := range kindleDateLayouts {
+		var date time.Time
+		if date, err = time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// atoiPtr parses a number matched by a regular expression.
+func atoiPtr(value string) *int {
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &number
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"flag"
 	"fmt"
+	"github.com/hayohtee/books/internal/blob"
 	"github.com/hayohtee/books/internal/cache"
 	"github.com/hayohtee/books/internal/data"
 	"github.com/hayohtee/books/internal/mailer"
@@ -12,6 +13,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -28,6 +30,18 @@ func main() {
 
 	flag.StringVar(&cfg.redisDSN, "redis-dsn", os.Getenv("REDIS_DSN"), "Redis DSN")
 
+	flag.StringVar(&cfg.exportDir, "export-dir", filepath.Join(os.TempDir(), "books-exports"), "Directory for the files of the exports")
+	flag.DurationVar(&cfg.export.retention, "export-retention", 7*24*time.Hour, "How long finished exports and their files are kept (0 keeps them forever)")
+	flag.DurationVar(&cfg.export.purgeInterval, "export-purge-interval", time.Hour, "How often the expired exports are purged")
+
+	flag.StringVar(&cfg.blob.store, "blob-store", "file", "Blob store for the book covers (file)")
+	flag.StringVar(&cfg.blob.dir, "blob-dir", filepath.Join(os.TempDir(), "books-blobs"), "Directory of the file blob store")
+
+	flag.BoolVar(&cfg.requireIfMatch, "require-if-match", false, "Require the If-Match header on requests changing a book")
+
+	flag.DurationVar(&cfg.trash.retention, "trash-retention", 30*24*time.Hour, "How long deleted books are kept in the trash (0 keeps them forever)")
+	flag.DurationVar(&cfg.trash.purgeInterval, "trash-purge-interval", time.Hour, "How often the trash is purged")
+
 	flag.StringVar(&cfg.smtp.host, "smtp-host", os.Getenv("SMTP_HOST"), "SMTP host")
 	flag.StringVar(&cfg.smtp.sender, "smtp-sender", os.Getenv("SMTP_SENDER"), "SMTP Sender")
 	flag.IntVar(&cfg.smtp.port, "smtp-port", 465, "SMTP port")
@@ -58,15 +72,32 @@ func main() {
 	defer redisClient.Close()
 	logger.Info("redis connection established")
 
+	blobs, err := openBlobStore(cfg)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error opening blob store: %v", err))
+		os.Exit(1)
+	}
+	logger.Info("blob store opened")
+
+	if !calibreSupported {
+		logger.Warn("built without cgo, importing Calibre libraries is disabled")
+	}
+
 	// Declare an instance of the application struct
 	app := &application{
 		cfg:     cfg,
 		logger:  logger,
+		db:      db,
 		queries: data.New(db),
 		mailer:  mailClient,
 		cache:   cache.New(redisClient),
+		blobs:   blobs,
+		stop:    make(chan struct{}),
 	}
 
+	app.background(app.purgeTrash)
+	app.background(app.purgeExports)
+
 	if err := app.serve(); err != nil {
 		logger.Error(fmt.Sprintf("error starting server: %v", err))
 		os.Exit(1)
@@ -111,3 +142,13 @@ func openRedis(dsn string) (*redis.Client, error) {
 
 	return client, nil
 }
+
+// openBlobStore opens the blob store of the kind the configuration names.
+func openBlobStore(cfg config) (blob.Store, error) {
+	switch cfg.blob.store {
+	case "file":
+		return blob.NewFileStore(cfg.blob.dir)
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", cfg.blob.store)
+	}
+}
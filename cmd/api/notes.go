@@ -0,0 +1,356 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+)
+
+func (app *application) ListNoteHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListNoteHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if params.Search != nil {
+		v.Check(len(*params.Search) <= 500, "search", "must be less than 500 characters")
+	}
+	if params.Kind != nil {
+		validateNoteKind(*params.Kind, v)
+	}
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	var search string
+	if params.Search != nil {
+		search = *params.Search
+	}
+	var kind string
+	if params.Kind != nil {
+		kind = string(*params.Kind)
+	}
+	var page = 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	var pageSize = 10
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	rows, err := app.queries.ListNoteForBook(r.Context(), data.ListNoteForBookParams{
+		BookID: book.ID,
+		Search: search,
+		Kind:   kind,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	var totalRecords int64
+	notes := make([]NoteResponse, 0, len(rows))
+	for _, value := range rows {
+		totalRecords = value.TotalRecords
+		notes = append(notes, newNoteResponse(value.Note))
+	}
+
+	resp := ListNoteResponse{
+		Metadata: calculateMetadata(int(totalRecords), page, pageSize),
+		Items:    notes,
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateNoteHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateNoteRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateNote(payload.Kind, payload.Body, payload.Page, payload.Chapter, payload.LocationStart, payload.LocationEnd, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	kind := Note
+	if payload.Kind != nil {
+		kind = *payload.Kind
+	}
+
+	note, err := app.queries.CreateNote(r.Context(), data.CreateNoteParams{
+		BookID:        book.ID,
+		UserID:        userID,
+		Kind:          string(kind),
+		Body:          payload.Body,
+		Page:          nullInt32(payload.Page),
+		Chapter:       nullString(payload.Chapter),
+		LocationStart: nullInt32(payload.LocationStart),
+		LocationEnd:   nullInt32(payload.LocationEnd),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/books/%s/notes/%s", book.ID, note.ID))
+
+	if err := app.writeJSON(w, http.StatusCreated, newNoteResponse(note), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetNoteHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, noteId openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	note, err := app.queries.GetNote(r.Context(), noteId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if note.BookID != id {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	if userID.String() != note.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newNoteResponse(note), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) UpdateNoteHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, noteId openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload UpdateNoteRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	note, err := app.queries.GetNote(r.Context(), noteId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if note.BookID != id {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	if userID.String() != note.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	// The note keeps its kind when the request leaves it out, and the body is
+	// checked against that kind.
+	kind := NoteKind(note.Kind)
+	if payload.Kind != nil {
+		kind = *payload.Kind
+	}
+
+	v := validator.New()
+	validateNote(&kind, payload.Body, payload.Page, payload.Chapter, payload.LocationStart, payload.LocationEnd, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	note, err = app.queries.UpdateNote(r.Context(), data.UpdateNoteParams{
+		Kind:          string(kind),
+		Body:          payload.Body,
+		Page:          nullInt32(payload.Page),
+		Chapter:       nullString(payload.Chapter),
+		LocationStart: nullInt32(payload.LocationStart),
+		LocationEnd:   nullInt32(payload.LocationEnd),
+		ID:            note.ID,
+		Version:       note.Version,
+		UserID:        userID,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newNoteResponse(note), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteNoteHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, noteId openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	note, err := app.queries.GetNote(r.Context(), noteId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if note.BookID != id {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	if userID.String() != note.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err = app.queries.DeleteNote(r.Context(), data.DeleteNoteParams{ID: note.ID, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Note deleted successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// validateNote checks the body of a note and its anchor. A note can be anchored
+// to a page, a chapter and an ebook location range, where the end of the range
+// is only allowed together with its start. Only a bookmark can be left without
+// a body, as it marks a place in the book.
+func validateNote(kind *NoteKind, body string, page *int, chapter *string, locationStart, locationEnd *int, v *validator.Validator) {
+	if kind != nil {
+		validateNoteKind(*kind, v)
+	}
+	v.Check(body != "" || (kind != nil && *kind == Bookmark), "body", "must be provided")
+	v.Check(len(body) <= 10_000, "body", "must not be more than 10000 bytes")
+	if page != nil {
+		v.Check(*page > 0, "page", "must be greater than zero")
+	}
+	if chapter != nil {
+		v.Check(*chapter != "", "chapter", "must not be empty")
+		v.Check(len(*chapter) <= 500, "chapter", "must not be more than 500 bytes")
+	}
+	if locationStart != nil {
+		v.Check(*locationStart >= 0, "location_start", "must not be negative")
+	}
+	if locationEnd != nil {
+		v.Check(locationStart != nil, "location_end", "must be provided together with location_start")
+		if locationStart != nil {
+			v.Check(*locationEnd >= *locationStart, "location_end", "must not be less than location_start")
+		}
+	}
+}
+
+func validateNoteKind(kind NoteKind, v *validator.Validator) {
+	v.Check(validator.PermittedValue(kind, Note, Quote, Highlight, Bookmark), "kind", "must be one of note, quote, highlight or bookmark")
+}
+
+// newNoteResponse maps a note record to its API representation.
+func newNoteResponse(note data.Note) NoteResponse {
+	return NoteResponse{
+		Id:            note.ID,
+		BookId:        note.BookID,
+		Kind:          NoteKind(note.Kind),
+		Body:          note.Body,
+		Page:          intPtr(note.Page),
+		Chapter:       stringPtr(note.Chapter),
+		LocationStart: intPtr(note.LocationStart),
+		LocationEnd:   intPtr(note.LocationEnd),
+		CreatedAt:     note.CreatedAt,
+		UpdatedAt:     note.UpdatedAt,
+	}
+}
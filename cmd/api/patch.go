@@ -0,0 +1,365 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The media types of the bodies of PATCH requests.
+const (
+	// mergePatchMediaType is the media type of a JSON Merge Patch (RFC 7396).
+	mergePatchMediaType = "application/merge-patch+json"
+	// jsonPatchMediaType is the media type of a JSON Patch (RFC 6902).
+	jsonPatchMediaType = "application/json-patch+json"
+)
+
+// errPatchTestFailed is returned when a test operation of a JSON Patch fails.
+var errPatchTestFailed = errors.New("test operation failed")
+
+// patchOperation is an operation of a JSON Patch. The value is kept as raw JSON
+// so that a missing value can be told apart from null.
+type patchOperation struct {
+	Op    JSONPatchOperationOp `json:"op"`
+	Path  string               `json:"path"`
+	From  *string              `json:"from"`
+	Value json.RawMessage      `json:"value"`
+}
+
+// readPatch reads the patch of the media type from the body and applies it to the
+// JSON representation of dst, then decodes the patched document back into dst.
+func readPatch(body io.Reader, mediaType string, dst any) error {
+	js, err := json.Marshal(dst)
+	if err != nil {
+		return err
+	}
+	var doc any
+	if err := json.Unmarshal(js, &doc); err != nil {
+		return err
+	}
+
+	// The optional fields left out of the JSON of dst are null in the document,
+	// so that a JSON Patch can test and replace them like any other field.
+	if object, ok := doc.(map[string]any); ok {
+		if t := reflect.TypeOf(dst).Elem(); t.Kind() == reflect.Struct {
+			for i := range t.NumField() {
+				name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+				if _, ok := object[name]; !ok && name != "" && name != "-" {
+					object[name] = nil
+				}
+			}
+		}
+	}
+
+	dec := json.NewDecoder(body)
+	switch mediaType {
+	case mergePatchMediaType:
+		var patch any
+		if err := decodeJSON(dec, &patch); err != nil {
+			return err
+		}
+		doc = mergePatch(doc, patch)
+	case jsonPatchMediaType:
+		dec.DisallowUnknownFields()
+		var operations []patchOperation
+		if err := decodeJSON(dec, &operations); err != nil {
+			return err
+		}
+		if doc, err = applyJSONPatch(doc, operations); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported patch media type %s", mediaType)
+	}
+
+	if js, err = json.Marshal(doc); err != nil {
+		return err
+	}
+
+	// The fields the patch removed must not keep their current value.
+	reflect.ValueOf(dst).Elem().SetZero()
+
+	dec = json.NewDecoder(bytes.NewReader(js))
+	dec.DisallowUnknownFields()
+	return decodeJSON(dec, dst)
+}
+
+// mergePatch applies a JSON Merge Patch to the target document.
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any)
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergePatch(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
+
+// applyJSONPatch applies the operations of a JSON Patch to the document in order,
+// stopping at the first operation that fails.
+func applyJSONPatch(doc any, operations []patchOperation) (any, error) {
+	for i, operation := range operations {
+		var err error
+		if doc, err = applyPatchOperation(doc, operation); err != nil {
+			return nil, fmt.Errorf("patch operation %d: %w", i, err)
+		}
+	}
+	return doc, nil
+}
+
+// applyPatchOperation applies an operation of a JSON Patch to the document.
+func applyPatchOperation(doc any, operation patchOperation) (any, error) {
+	path, err := parsePointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	switch operation.Op {
+	case Add, Replace, Test:
+		if operation.Value == nil {
+			return nil, fmt.Errorf("%s must have a value", operation.Op)
+		}
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return nil, err
+		}
+	case Move, Copy:
+		if operation.From == nil {
+			return nil, fmt.Errorf("%s must have a from", operation.Op)
+		}
+	case Remove:
+	default:
+		return nil, fmt.Errorf("unknown op %q", operation.Op)
+	}
+
+	switch operation.Op {
+	case Add:
+		return pointerAdd(doc, path, value)
+	case Remove:
+		return pointerRemove(doc, path)
+	case Replace:
+		if len(path) == 0 {
+			return value, nil
+		}
+		if doc, err = pointerRemove(doc, path); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, path, value)
+	case Move:
+		from, err := parsePointer(*operation.From)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(operation.Path, *operation.From+"/") {
+			return nil, errors.New("cannot move a value into one of its children")
+		}
+		if value, err = pointerGet(doc, from); err != nil {
+			return nil, err
+		}
+		if doc, err = pointerRemove(doc, from); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, path, value)
+	case Copy:
+		from, err := parsePointer(*operation.From)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = pointerGet(doc, from); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, path, deepCopy(value))
+	default: // Test
+		current, err := pointerGet(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(current, value) {
+			return nil, fmt.Errorf("%w: the value at %q is not the expected value", errPatchTestFailed, operation.Path)
+		}
+		return doc, nil
+	}
+}
+
+// parsePointer splits a JSON Pointer (RFC 6901) into its unescaped reference
+// tokens. The empty pointer refers to the whole document.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path %q must start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// pointerGet returns the value the reference tokens point to.
+func pointerGet(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("path member %q does not exist", token)
+			}
+			doc = value
+		case []any:
+			i, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[i]
+		default:
+			return nil, fmt.Errorf("path member %q does not exist", token)
+		}
+	}
+	return doc, nil
+}
+
+// pointerAdd adds the value at the reference tokens, replacing the member of an
+// object or inserting the element of an array that is there, and returns the
+// patched document.
+func pointerAdd(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return pointerUpdate(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			if token == "-" {
+				return append(node, value), nil
+			}
+			i, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:i], append([]any{value}, node[i:]...)...), nil
+		default:
+			return nil, fmt.Errorf("cannot add %q to a value that is neither an object nor an array", token)
+		}
+	})
+}
+
+// pointerRemove removes the value at the reference tokens, which must exist, and
+// returns the patched document.
+func pointerRemove(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, errors.New("cannot remove the whole document")
+	}
+
+	return pointerUpdate(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("path member %q does not exist", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []any:
+			i, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:i], node[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("path member %q does not exist", token)
+		}
+	})
+}
+
+// pointerUpdate calls update with the container the last reference token points
+// into and that token, then replaces the container with the one update returns,
+// so that arrays can grow and shrink.
+func pointerUpdate(doc any, path []string, update func(container any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return update(doc, path[0])
+	}
+
+	switch node := doc.(type) {
+	case map[string]any:
+		child, ok := node[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("path member %q does not exist", path[0])
+		}
+		child, err := pointerUpdate(child, path[1:], update)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = child
+		return node, nil
+	case []any:
+		i, err := arrayIndex(path[0], len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		child, err := pointerUpdate(node[i], path[1:], update)
+		if err != nil {
+			return nil, err
+		}
+		node[i] = child
+		return node, nil
+	default:
+		return nil, fmt.Errorf("path member %q does not exist", path[0])
+	}
+}
+
+// arrayIndex parses a reference token as the index of an array element, which
+// must not be greater than the maximum.
+func arrayIndex(token string, maximum int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("path member %q is not an array index", token)
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i > maximum {
+		return 0, fmt.Errorf("array index %q is out of bounds", token)
+	}
+	return i, nil
+}
+
+// deepCopy copies a decoded JSON value, so that changing the copy leaves the
+// value as it was.
+func deepCopy(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, member := range value {
+			copied[key] = deepCopy(member)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(value))
+		for i, element := range value {
+			copied[i] = deepCopy(element)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"time"
+)
+
+// readingTransitions holds the statuses a book is allowed to move to from each reading status.
+var readingTransitions = map[ReadingStatus][]ReadingStatus{
+	WantToRead: {Reading, Finished},
+	Reading:    {WantToRead, Finished, Abandoned},
+	Finished:   {Reading},
+	Abandoned:  {WantToRead, Reading},
+}
+
+func (app *application) UpdateReadingProgressHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params UpdateReadingProgressHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload UpdateReadingProgressRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateUpdateReadingProgressRequest(payload, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if !app.checkIfMatch(w, r, params.IfMatch, bookETag(book)) {
+		return
+	}
+
+	progress := nextReadingProgress(book, payload, time.Now(), v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err = app.queries.UpdateReadingProgress(r.Context(), progress)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if payload.Status != nil && *payload.Status == Finished && book.FinishedAt.Valid {
+		app.checkGoals(userID, book.FinishedAt.Time.Year())
+	}
+
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("ETag", bookETag(book))
+
+	if err := app.writeJSON(w, http.StatusOK, resp, header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func validateUpdateReadingProgressRequest(r UpdateReadingProgressRequest, v *validator.Validator) {
+	v.Check(r.Status != nil || r.CurrentPage != nil || r.ProgressPercent != nil, "status", "at least one of status, current_page or progress_percent must be provided")
+	if r.Status != nil {
+		validateReadingStatus(*r.Status, v)
+	}
+	if r.CurrentPage != nil {
+		v.Check(*r.CurrentPage >= 0, "current_page", "must not be negative")
+	}
+	if r.ProgressPercent != nil {
+		v.Check(*r.ProgressPercent >= 0, "progress_percent", "must not be negative")
+		v.Check(*r.ProgressPercent <= 100, "progress_percent", "must not be more than 100")
+	}
+}
+
+func validateReadingStatus(status ReadingStatus, v *validator.Validator) {
+	v.Check(validator.PermittedValue(status, WantToRead, Reading, Finished, Abandoned), "status", "must be one of want_to_read, reading, finished or abandoned")
+}
+
+// nextReadingProgress works out the new reading state of a book from the requested
+// changes. It checks that the status transition is allowed, stamps started_at and
+// finished_at with the given time, and derives the progress percentage from the
+// current page when the page count of the book is known.
+func nextReadingProgress(book data.Book, r UpdateReadingProgressRequest, now time.Time, v *validator.Validator) data.UpdateReadingProgressParams {
+	next := data.UpdateReadingProgressParams{
+		Status:          book.Status,
+		CurrentPage:     book.CurrentPage,
+		ProgressPercent: book.ProgressPercent,
+		StartedAt:       book.StartedAt,
+		FinishedAt:      book.FinishedAt,
+		ID:              book.ID,
+		Version:         book.Version,
+		UserID:          book.UserID,
+	}
+
+	current := ReadingStatus(book.Status)
+	if r.Status != nil && *r.Status != current {
+		target := *r.Status
+		if !validator.PermittedValue(target, readingTransitions[current]...) {
+			v.AddError("status", fmt.Sprintf("cannot change from %s to %s", current, target))
+			return next
+		}
+
+		stamp := sql.NullTime{Time: now, Valid: true}
+		next.Status = string(target)
+
+		switch target {
+		case WantToRead:
+			next.CurrentPage = sql.NullInt32{}
+			next.ProgressPercent = sql.NullInt32{}
+			next.StartedAt = sql.NullTime{}
+			next.FinishedAt = sql.NullTime{}
+		case Reading:
+			// Resuming an abandoned book keeps its start date and progress,
+			// while starting or re-reading a book begins from scratch.
+			if current != Abandoned || !next.StartedAt.Valid {
+				next.StartedAt = stamp
+				next.CurrentPage = sql.NullInt32{}
+				next.ProgressPercent = sql.NullInt32{}
+			}
+			next.FinishedAt = sql.NullTime{}
+		case Finished:
+			if !next.StartedAt.Valid {
+				next.StartedAt = stamp
+			}
+			next.FinishedAt = stamp
+			next.CurrentPage = book.PageCount
+			next.ProgressPercent = sql.NullInt32{Int32: 100, Valid: true}
+		case Abandoned:
+			next.FinishedAt = stamp
+		}
+	}
+
+	if r.CurrentPage == nil && r.ProgressPercent == nil {
+		return next
+	}
+
+	status := ReadingStatus(next.Status)
+	if status != Reading && status != Abandoned {
+		v.AddError("current_page", fmt.Sprintf("cannot record progress for a book that is %s", status))
+		return next
+	}
+
+	if r.CurrentPage != nil {
+		next.CurrentPage = nullInt32(r.CurrentPage)
+		if book.PageCount.Valid {
+			pageCount := int(book.PageCount.Int32)
+			v.Check(*r.CurrentPage <= pageCount, "current_page", "must not be more than the page count of the book")
+			next.ProgressPercent = sql.NullInt32{Int32: int32(*r.CurrentPage * 100 / pageCount), Valid: true}
+		}
+	}
+	if r.ProgressPercent != nil {
+		next.ProgressPercent = nullInt32(r.ProgressPercent)
+	}
+
+	return next
+}
+
+// initialReadingDates returns the started_at and finished_at timestamps
+// for a book that is created with the given reading status.
+func initialReadingDates(status ReadingStatus, now time.Time) (startedAt, finishedAt sql.NullTime) {
+	stamp := sql.NullTime{Time: now, Valid: true}
+	switch status {
+	case Reading:
+		return stamp, sql.NullTime{}
+	case Finished, Abandoned:
+		return stamp, stamp
+	default:
+		return sql.NullTime{}, sql.NullTime{}
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"math"
+	"net/http"
+)
+
+func (app *application) ListBookReviewHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListBookReviewHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	var page = 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	var pageSize = 10
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	rows, err := app.queries.ListReviewForBook(r.Context(), data.ListReviewForBookParams{
+		BookID: book.ID,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	var totalRecords int64
+	reviews := make([]ReviewResponse, 0, len(rows))
+	for _, value := range rows {
+		totalRecords = value.TotalRecords
+		reviews = append(reviews, newReviewResponse(value.Review))
+	}
+
+	resp := ListReviewResponse{
+		Metadata: calculateMetadata(int(totalRecords), page, pageSize),
+		Items:    reviews,
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateReviewHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateReviewRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateReview(payload.Rating, payload.Body, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	review, err := app.queries.CreateReview(r.Context(), data.CreateReviewParams{
+		BookID:  book.ID,
+		UserID:  userID,
+		Rating:  payload.Rating,
+		Body:    nullString(payload.Body),
+		Spoiler: payload.Spoiler != nil && *payload.Spoiler,
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/reviews/%s", review.ID))
+
+	if err := app.writeJSON(w, http.StatusCreated, newReviewResponse(review), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetReviewHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	review, err := app.queries.GetReview(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != review.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newReviewResponse(review), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) UpdateReviewHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload UpdateReviewRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateReview(payload.Rating, payload.Body, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	review, err := app.queries.GetReview(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != review.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	review, err = app.queries.UpdateReview(r.Context(), data.UpdateReviewParams{
+		Rating:  payload.Rating,
+		Body:    nullString(payload.Body),
+		Spoiler: payload.Spoiler != nil && *payload.Spoiler,
+		ID:      review.ID,
+		Version: review.Version,
+		UserID:  userID,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newReviewResponse(review), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteReviewHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	review, err := app.queries.GetReview(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != review.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err = app.queries.DeleteReview(r.Context(), data.DeleteReviewParams{ID: id, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Review deleted successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func validateReview(rating float64, body *string, v *validator.Validator) {
+	v.Check(rating >= 1 && rating <= 5, "rating", "must be between 1 and 5")
+	v.Check(math.Mod(rating*2, 1) == 0, "rating", "must be a whole or half star")
+	if body != nil {
+		v.Check(*body != "", "body", "must not be empty")
+		v.Check(len(*body) <= 10_000, "body", "must not be more than 10000 bytes")
+	}
+}
+
+// newReviewResponse maps a review record to its API representation.
+func newReviewResponse(review data.Review) ReviewResponse {
+	return ReviewResponse{
+		Id:        review.ID,
+		BookId:    review.BookID,
+		UserId:    review.UserID,
+		Rating:    review.Rating,
+		Body:      stringPtr(review.Body),
+		Spoiler:   review.Spoiler,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
@@ -2,12 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	middleware "github.com/oapi-codegen/nethttp-middleware"
 	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 )
 
@@ -58,7 +62,37 @@ func (app *application) serve() error {
 		ErrorLog:     slog.NewLogLogger(app.logger.Handler(), slog.LevelError),
 	}
 
-	return srv.ListenAndServe()
+	// On SIGINT or SIGTERM the server stops accepting requests and waits for the
+	// requests in progress, then the background jobs are stopped and waited for,
+	// so that none of them is killed halfway through.
+	shutdownError := make(chan error)
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		s := <-quit
+
+		app.logger.Info(fmt.Sprintf("shutting down server on %s", s))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := srv.Shutdown(ctx)
+		close(app.stop)
+		app.wg.Wait()
+		shutdownError <- err
+	}()
+
+	err = srv.ListenAndServe()
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	if err := <-shutdownError; err != nil {
+		return err
+	}
+
+	app.logger.Info("stopped server")
+	return nil
 }
 
 func fixSwaggerPrefix(prefix string, swagger *openapi3.T) {
@@ -70,3 +104,15 @@ func fixSwaggerPrefix(prefix string, swagger *openapi3.T) {
 
 	swagger.Paths = &updatedPaths
 }
+
+// extendUploadDeadlines extends the deadlines of the connection for reading a body
+// of up to size bytes, which takes longer to upload than the read timeout of the
+// server allows. The body is given a second per 128KB, about 1Mbit/s, on top of
+// the timeouts of the server.
+func extendUploadDeadlines(w http.ResponseWriter, size int64) {
+	duration := time.Duration(size/(128<<10)) * time.Second
+
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Now().Add(5*time.Second + duration))
+	_ = rc.SetWriteDeadline(time.Now().Add(10*time.Second + duration))
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"time"
+)
+
+func (app *application) ListReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListReadingSessionHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	var page = 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	var pageSize = 10
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	rows, err := app.queries.ListReadingSessionForBook(r.Context(), data.ListReadingSessionForBookParams{
+		BookID: book.ID,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	var totalRecords int64
+	sessions := make([]ReadingSessionResponse, 0, len(rows))
+	for _, value := range rows {
+		totalRecords = value.TotalRecords
+		sessions = append(sessions, newReadingSessionResponse(value.ReadingSession))
+	}
+
+	resp := ListReadingSessionResponse{
+		Metadata: calculateMetadata(int(totalRecords), page, pageSize),
+		Items:    sessions,
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateReadingSessionRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateCreateReadingSessionRequest(payload, time.Now(), v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if book.PageCount.Valid {
+		v.Check(payload.PagesRead <= int(book.PageCount.Int32), "pages_read", "must not be more than the page count of the book")
+		if !v.Valid() {
+			app.failedValidationResponse(w, r, v.Errors)
+			return
+		}
+	}
+
+	session, err := app.queries.CreateReadingSession(r.Context(), data.CreateReadingSessionParams{
+		BookID:    book.ID,
+		UserID:    userID,
+		StartedAt: payload.StartedAt,
+		EndedAt:   payload.EndedAt,
+		PagesRead: int32(payload.PagesRead),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	app.checkGoals(userID, session.StartedAt.Year())
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/books/%s/sessions/%s", book.ID, session.ID))
+
+	if err := app.writeJSON(w, http.StatusCreated, newReadingSessionResponse(session), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteReadingSessionHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, sessionId openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	session, err := app.queries.GetReadingSession(r.Context(), sessionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if session.BookID != id {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	if userID.String() != session.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err = app.queries.DeleteReadingSession(r.Context(), data.DeleteReadingSessionParams{ID: session.ID, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Reading session deleted successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func validateCreateReadingSessionRequest(r CreateReadingSessionRequest, now time.Time, v *validator.Validator) {
+	v.Check(!r.StartedAt.IsZero(), "started_at", "must be provided")
+	v.Check(!r.EndedAt.IsZero(), "ended_at", "must be provided")
+	v.Check(r.EndedAt.After(r.StartedAt), "ended_at", "must be after started_at")
+	v.Check(!r.EndedAt.After(now), "ended_at", "must not be in the future")
+	v.Check(r.EndedAt.Sub(r.StartedAt) <= 24*time.Hour, "ended_at", "must not be more than 24 hours after started_at")
+	v.Check(r.PagesRead >= 0, "pages_read", "must not be negative")
+	v.Check(r.PagesRead <= 100_000, "pages_read", "must not be more than 100000")
+}
+
+// newReadingSessionResponse maps a reading session record to its API representation.
+func newReadingSessionResponse(session data.ReadingSession) ReadingSessionResponse {
+	return ReadingSessionResponse{
+		Id:        session.ID,
+		BookId:    session.BookID,
+		StartedAt: session.StartedAt,
+		EndedAt:   session.EndedAt,
+		PagesRead: int(session.PagesRead),
+		CreatedAt: session.CreatedAt,
+	}
+}
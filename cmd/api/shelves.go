@@ -0,0 +1,375 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"strings"
+)
+
+func (app *application) ListShelfHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	rows, err := app.queries.ListShelfForUser(r.Context(), userID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	shelves := make([]ShelfResponse, 0, len(rows))
+	for _, shelf := range rows {
+		shelves = append(shelves, newShelfResponse(shelf))
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, ListShelfResponse{Items: shelves}, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) CreateShelfHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload CreateShelfRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateShelf(payload.Name, payload.Description, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	shelf, err := app.queries.CreateShelf(r.Context(), data.CreateShelfParams{
+		UserID:      userID,
+		Name:        payload.Name,
+		Description: nullString(payload.Description),
+	})
+
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "shelves_user_id_name_key"):
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: "Shelf already exists"})
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	header := make(http.Header)
+	header.Set("Location", fmt.Sprintf("/shelves/%s", shelf.ID))
+
+	if err := app.writeJSON(w, http.StatusCreated, newShelfResponse(shelf), header); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) GetShelfHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newShelfResponse(shelf), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) UpdateShelfHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload UpdateShelfRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	validateShelf(payload.Name, payload.Description, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	shelf, err = app.queries.UpdateShelf(r.Context(), data.UpdateShelfParams{
+		Name:        payload.Name,
+		Description: nullString(payload.Description),
+		ID:          shelf.ID,
+		Version:     shelf.Version,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.editConflictResponse(w, r)
+		case strings.Contains(err.Error(), "shelves_user_id_name_key"):
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: "Shelf already exists"})
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, newShelfResponse(shelf), nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteShelfHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	if err = app.queries.DeleteShelf(r.Context(), data.DeleteShelfParams{ID: id, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Shelf deleted successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) ListShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, params ListShelfBookHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	filters := data.ListBookForUserParams{
+		UserID:  userID,
+		ShelfID: uuid.NullUUID{UUID: shelf.ID, Valid: true},
+		Sort:    "name",
+	}
+
+	app.listBooks(w, r, filters, params.Page, params.PageSize, params.Cursor, params.IncludeTotal)
+}
+
+func (app *application) AddShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload AddShelfBookRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	v.Check(payload.BookId != uuid.Nil, "book_id", "must be provided")
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), payload.BookId)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	err = app.queries.AddBookToShelf(r.Context(), data.AddBookToShelfParams{ShelfID: shelf.ID, BookID: book.ID})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Book added to shelf successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) RemoveShelfBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, bookId openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	shelf, err := app.queries.GetShelf(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != shelf.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	rowsAffected, err := app.queries.RemoveBookFromShelf(r.Context(), data.RemoveBookFromShelfParams{ShelfID: shelf.ID, BookID: bookId})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if rowsAffected == 0 {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Book removed from shelf successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func validateShelf(name string, description *string, v *validator.Validator) {
+	v.Check(name != "", "name", "must be provided")
+	v.Check(len(name) <= 200, "name", "must not be more than 200 bytes")
+	if description != nil {
+		v.Check(len(*description) <= 1000, "description", "must not be more than 1000 bytes")
+	}
+}
+
+// newShelfResponse maps a shelf record to its API representation.
+func newShelfResponse(shelf data.Shelf) ShelfResponse {
+	return ShelfResponse{
+		Id:          shelf.ID,
+		Name:        shelf.Name,
+		Description: stringPtr(shelf.Description),
+		UserId:      shelf.UserID,
+		CreatedAt:   shelf.CreatedAt,
+		UpdatedAt:   shelf.UpdatedAt,
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"time"
+)
+
+func (app *application) GetUserStatsHandler(w http.ResponseWriter, r *http.Request, params GetUserStatsHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	now := time.Now()
+	year := now.Year()
+	if params.Year != nil {
+		year = *params.Year
+	}
+
+	v := validator.New()
+	v.Check(year > 0, "year", "must be greater than zero")
+	v.Check(year <= now.Year(), "year", "must not be in the future")
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	ctx := r.Context()
+
+	perYear, err := app.queries.CountBooksFinishedPerYear(ctx, userID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	perMonth, err := app.queries.CountBooksFinishedPerMonth(ctx, data.CountBooksFinishedPerMonthParams{UserID: userID, Year: int32(year)})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	perGenre, err := app.queries.CountBooksFinishedPerGenre(ctx, data.CountBooksFinishedPerGenreParams{UserID: userID, Year: int32(year)})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	perDay, err := app.queries.ListPagesReadPerDay(ctx, data.ListPagesReadPerDayParams{UserID: userID, Year: int32(year)})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	totals, err := app.queries.GetReadingTotals(ctx, data.GetReadingTotalsParams{UserID: userID, Year: int32(year)})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	longestStreak, err := app.queries.GetLongestReadingStreak(ctx, userID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := ReadingStatsResponse{
+		Year:                  year,
+		BooksFinishedPerYear:  make([]YearlyBooksFinished, 0, len(perYear)),
+		BooksFinishedPerMonth: make([]MonthlyBooksFinished, 12),
+		PagesPerDay:           make([]DailyPagesRead, 0, len(perDay)),
+		PagesRead:             int(totals.PagesRead),
+		LongestStreak:         int(longestStreak),
+		Genres:                make([]GenreBooksFinished, 0, len(perGenre)),
+	}
+
+	for _, row := range perYear {
+		resp.BooksFinishedPerYear = append(resp.BooksFinishedPerYear, YearlyBooksFinished{Year: int(row.Year), BooksFinished: int(row.BooksFinished)})
+	}
+	for i := range resp.BooksFinishedPerMonth {
+		resp.BooksFinishedPerMonth[i].Month = i + 1
+	}
+	for _, row := range perMonth {
+		resp.BooksFinishedPerMonth[row.Month-1].BooksFinished = int(row.BooksFinished)
+	}
+	for _, row := range perDay {
+		resp.PagesPerDay = append(resp.PagesPerDay, DailyPagesRead{Date: openapitypes.Date{Time: row.Day}, PagesRead: int(row.PagesRead)})
+	}
+	for _, row := range perGenre {
+		resp.Genres = append(resp.Genres, GenreBooksFinished{Genre: row.Genre, BooksFinished: int(row.BooksFinished)})
+	}
+
+	if days := daysElapsed(year, now); days > 0 {
+		resp.AveragePagesPerDay = float64(totals.PagesRead) / float64(days)
+	}
+	if totals.ReadingSeconds > 0 {
+		resp.AveragePagesPerHour = float64(totals.PagesRead) / (totals.ReadingSeconds / 3600)
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// daysElapsed returns the number of days of the given year up to and including
+// today, or the number of days in the year when the year is already over.
+func daysElapsed(year int, now time.Time) int {
+	if year < now.Year() {
+		return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	return now.YearDay()
+}
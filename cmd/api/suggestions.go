@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	"net/http"
+	"strings"
+)
+
+// likeEscaper escapes the characters that have a special meaning in a LIKE pattern,
+// so that the text typed by the user is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (app *application) SuggestBookHandler(w http.ResponseWriter, r *http.Request, params SuggestBookHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	query := strings.TrimSpace(params.Q)
+	var limit = 5
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	v := validator.New()
+	v.Check(query != "", "q", "must be provided")
+	v.Check(len(params.Q) <= 100, "q", "must not be more than 100 bytes")
+	v.Check(limit > 0, "limit", "must be greater than zero")
+	v.Check(limit <= 20, "limit", "must be a maximum of 20")
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	ctx := r.Context()
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+
+	books, err := app.queries.ListBookSuggestions(ctx, data.ListBookSuggestionsParams{
+		UserID:  userID,
+		Pattern: pattern,
+		Query:   query,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	authors, err := app.queries.ListAuthorSuggestions(ctx, data.ListAuthorSuggestionsParams{
+		UserID:  userID,
+		Pattern: pattern,
+		Query:   query,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	tags, err := app.queries.ListTagSuggestions(ctx, data.ListTagSuggestionsParams{
+		UserID:  userID,
+		Pattern: pattern,
+		Query:   query,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := SuggestionsResponse{
+		Books:   make([]BookSuggestion, 0, len(books)),
+		Authors: make([]string, 0, len(authors)),
+		Tags:    make([]string, 0, len(tags)),
+	}
+
+	for _, book := range books {
+		resp.Books = append(resp.Books, BookSuggestion{Id: book.ID, Name: book.Name})
+	}
+	for _, author := range authors {
+		resp.Authors = append(resp.Authors, author.String)
+	}
+	resp.Tags = append(resp.Tags, tags...)
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"strings"
+)
+
+func (app *application) ListTagHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	rows, err := app.queries.ListTagsForUser(r.Context(), userID)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	tags := make([]TagResponse, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, TagResponse{Name: row.Name, BookCount: int(row.BookCount)})
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, ListTagResponse{Items: tags}, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) AddBookTagsHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	var payload AddBookTagsRequest
+	if err := app.readJSON(w, r, &payload); err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	v := validator.New()
+	v.Check(len(payload.Tags) > 0, "tags", "must contain at least one tag")
+	validateTags(payload.Tags, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	err = app.queries.AddTagsToBook(r.Context(), data.AddTagsToBookParams{
+		UserID: userID,
+		Names:  normalizeTags(payload.Tags),
+		BookID: book.ID,
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) RemoveBookTagHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID, tag string) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	book, err := app.queries.GetBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return
+	}
+
+	tag = strings.TrimSpace(tag)
+	rowsAffected, err := app.queries.RemoveTagFromBook(r.Context(), data.RemoveTagFromBookParams{BookID: book.ID, Name: tag})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if rowsAffected == 0 {
+		app.notFoundResponse(w, r)
+		return
+	}
+
+	// Tags only exist while at least one book uses them.
+	if err = app.queries.DeleteUnusedTag(r.Context(), data.DeleteUnusedTagParams{UserID: userID, Name: tag}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Tag removed from book successfully",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func validateTags(tags []string, v *validator.Validator) {
+	v.Check(len(tags) <= 20, "tags", "must not contain more than 20 tags")
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		v.Check(tag != "", "tags", "must not contain empty tags")
+		v.Check(len(tag) <= 50, "tags", "must not contain tags more than 50 bytes")
+	}
+}
+
+// normalizeTags trims the surrounding whitespace from each tag and drops the
+// tags that only differ by case, keeping the first spelling that was provided.
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/hayohtee/books/internal/data"
+	"github.com/hayohtee/books/internal/validator"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"net/http"
+	"time"
+)
+
+func (app *application) ListTrashHandler(w http.ResponseWriter, r *http.Request, params ListTrashHandlerParams) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	v := validator.New()
+	validatePagination(params.Page, params.PageSize, v)
+	if !v.Valid() {
+		app.failedValidationResponse(w, r, v.Errors)
+		return
+	}
+
+	var page = 1
+	if params.Page != nil {
+		page = *params.Page
+	}
+	var pageSize = 10
+	if params.PageSize != nil {
+		pageSize = *params.PageSize
+	}
+
+	rows, err := app.queries.ListTrashedBookForUser(r.Context(), data.ListTrashedBookForUserParams{
+		UserID: userID,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	bookIDs := make([]uuid.UUID, 0, len(rows))
+	for _, value := range rows {
+		bookIDs = append(bookIDs, value.Book.ID)
+	}
+
+	tags, err := app.queries.ListTagsForBooks(r.Context(), bookIDs)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	bookTags := make(map[uuid.UUID][]string)
+	for _, tag := range tags {
+		bookTags[tag.BookID] = append(bookTags[tag.BookID], tag.Name)
+	}
+
+	ratings, err := app.queries.ListAverageRatingForBooks(r.Context(), bookIDs)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	averageRatings := make(map[uuid.UUID]float64, len(ratings))
+	for _, rating := range ratings {
+		averageRatings[rating.BookID] = rating.AverageRating
+	}
+
+	var totalRecords int64
+	books := make([]TrashedBookResponse, 0, len(rows))
+	for _, value := range rows {
+		totalRecords = value.TotalRecords
+		book := app.newTrashedBookResponse(value.Book, bookTags[value.Book.ID])
+		if rating, ok := averageRatings[value.Book.ID]; ok {
+			book.Book.AverageRating = &rating
+		}
+		books = append(books, book)
+	}
+
+	resp := ListTrashResponse{
+		Metadata: calculateMetadata(int(totalRecords), page, pageSize),
+		Items:    books,
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) RestoreTrashedBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	book, err := app.getTrashedBookForUser(w, r, userID, id)
+	if err != nil {
+		return
+	}
+
+	book, err = app.queries.RestoreBook(r.Context(), data.RestoreBookParams{ID: book.ID, UserID: userID})
+	if err != nil {
+		message, conflict := bookConflictMessage(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		case conflict:
+			app.errorResponse(w, r, http.StatusConflict, Error{Message: message})
+		default:
+			app.serverError(w, r, err)
+		}
+		return
+	}
+
+	// A finished book counts towards the goals of the user again.
+	if book.FinishedAt.Valid {
+		app.checkGoals(userID, book.FinishedAt.Time.Year())
+	}
+
+	resp, err := app.bookResponse(r.Context(), book)
+	if err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+func (app *application) DeleteTrashedBookHandler(w http.ResponseWriter, r *http.Request, id openapitypes.UUID) {
+	userID, err := app.contextGetUserID(r)
+	if err != nil || userID == uuid.Nil {
+		app.authenticationRequiredResponse(w, r)
+		return
+	}
+
+	book, err := app.getTrashedBookForUser(w, r, userID, id)
+	if err != nil {
+		return
+	}
+
+	if err = app.queries.DeleteTrashedBook(r.Context(), data.DeleteTrashedBookParams{ID: book.ID, UserID: userID}); err != nil {
+		app.serverError(w, r, err)
+		return
+	}
+
+	if book.CoverKey.Valid {
+		app.deleteCover(book.CoverKey.String, book.CoverFormat.String)
+	}
+
+	resp := map[string]string{
+		"message": "Book permanently deleted",
+	}
+
+	if err := app.writeJSON(w, http.StatusOK, resp, nil); err != nil {
+		app.serverError(w, r, err)
+	}
+}
+
+// getTrashedBookForUser retrieves the book in the trash with the given ID, sending
+// the error response and returning an error when it does not exist or belongs to
+// another user.
+func (app *application) getTrashedBookForUser(w http.ResponseWriter, r *http.Request, userID, id uuid.UUID) (data.Book, error) {
+	book, err := app.queries.GetTrashedBook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			app.notFoundResponse(w, r)
+		default:
+			app.serverError(w, r, err)
+		}
+		return data.Book{}, err
+	}
+
+	if userID.String() != book.UserID.String() {
+		app.notPermittedResponse(w, r)
+		return data.Book{}, errors.New("book belongs to another user")
+	}
+
+	return book, nil
+}
+
+// purgeTrash permanently deletes the books that have been in the trash for longer
+// than the retention period, along with their covers, once per purge interval
+// until the server shuts down. It does nothing when the books are kept forever.
+func (app *application) purgeTrash() {
+	if app.cfg.trash.retention <= 0 || app.cfg.trash.purgeInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(app.cfg.trash.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		purged, err := app.queries.PurgeTrashedBooks(ctx, time.Now().Add(-app.cfg.trash.retention))
+		cancel()
+
+		switch {
+		case err != nil:
+			app.logger.Error(fmt.Sprintf("purging trash: %v", err))
+		case len(purged) > 0:
+			for _, book := range purged {
+				if book.CoverKey.Valid {
+					app.deleteCover(book.CoverKey.String, book.CoverFormat.String)
+				}
+			}
+			app.logger.Info(fmt.Sprintf("purged %d books from the trash", len(purged)))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-app.stop:
+			return
+		}
+	}
+}
+
+// newTrashedBookResponse maps a book in the trash and its tags to its API representation.
+func (app *application) newTrashedBookResponse(book data.Book, tags []string) TrashedBookResponse {
+	resp := TrashedBookResponse{
+		Book:      newBookResponse(book, tags),
+		DeletedAt: book.DeletedAt.Time,
+	}
+	if app.cfg.trash.retention > 0 {
+		purgeAt := book.DeletedAt.Time.Add(app.cfg.trash.retention)
+		resp.PurgeAt = &purgeAt
+	}
+	return resp
+}
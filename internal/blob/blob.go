@@ -0,0 +1,23 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotFound = errors.New("blob not found")
+)
+
+// Store stores blobs, such as the images of book covers, under keys made of
+// slash-separated path elements. A blob replaces the blob stored under its key.
+type Store interface {
+	// Put stores the content read from r under the key.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get opens the blob stored under the key, returning ErrNotFound when
+	// there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under the key, if there is one.
+	Delete(ctx context.Context, key string) error
+}
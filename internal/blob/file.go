@@ -0,0 +1,102 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileStore is a Store keeping the blobs as files in a directory of the local
+// filesystem.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a FileStore keeping the blobs in dir, which is created
+// when it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: filepath.Clean(dir)}, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// The content is written to a temporary file renamed over the blob once
+	// complete, so that a reader never sees a partly written blob.
+	file, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	// The directories left empty are removed along with the blob. Removing a
+	// directory that still holds other blobs fails, which ends the cleanup.
+	for dir := filepath.Dir(path); len(dir) > len(s.dir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// path returns the path of the file of the blob stored under the key, refusing
+// keys that would lead out of the directory of the store.
+func (s *FileStore) path(key string) (string, error) {
+	local, err := filepath.Localize(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid blob key %q: %w", key, err)
+	}
+	return filepath.Join(s.dir, local), nil
+}
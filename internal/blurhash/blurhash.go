@@ -0,0 +1,131 @@
+package blurhash
+
+import (
+	"errors"
+	"image"
+	"math"
+	"strings"
+)
+
+// characters is the alphabet of the base 83 encoding of blurhashes.
+const characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+var (
+	ErrInvalidComponents = errors.New("components must be between 1 and 9")
+	ErrEmptyImage        = errors.New("image must not be empty")
+)
+
+// Encode returns the blurhash of the image, a short string clients decode into
+// a blurred placeholder of the image while the image itself loads. The image is
+// described by xComponents by yComponents cosine components, where more
+// components keep more of its detail. Encoding reads every pixel of the image,
+// so it is best given a small version of it.
+func Encode(img image.Image, xComponents, yComponents int) (string, error) {
+	if xComponents < 1 || xComponents > 9 || yComponents < 1 || yComponents > 9 {
+		return "", ErrInvalidComponents
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return "", ErrEmptyImage
+	}
+
+	// The pixels are converted to linear RGB once, rather than once per component.
+	pixels := make([][3]float64, 0, width*height)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			pixels = append(pixels, [3]float64{
+				sRGBToLinear(r >> 8),
+				sRGBToLinear(g >> 8),
+				sRGBToLinear(b >> 8),
+			})
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := range yComponents {
+		for i := range xComponents {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+
+			var factor [3]float64
+			for y := range height {
+				for x := range width {
+					basis := math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) *
+						math.Cos(math.Pi*float64(j)*float64(y)/float64(height))
+					pixel := pixels[y*width+x]
+					factor[0] += basis * pixel[0]
+					factor[1] += basis * pixel[1]
+					factor[2] += basis * pixel[2]
+				}
+			}
+
+			scale := normalisation / float64(width*height)
+			factors = append(factors, [3]float64{factor[0] * scale, factor[1] * scale, factor[2] * scale})
+		}
+	}
+
+	var hash strings.Builder
+	encode83(&hash, (xComponents-1)+(yComponents-1)*9, 1)
+
+	dc, ac := factors[0], factors[1:]
+
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		var actualMaximum float64
+		for _, factor := range ac {
+			actualMaximum = max(actualMaximum, math.Abs(factor[0]), math.Abs(factor[1]), math.Abs(factor[2]))
+		}
+		quantisedMaximum := int(max(0, min(82, math.Floor(actualMaximum*166-0.5))))
+		maximumValue = float64(quantisedMaximum+1) / 166
+		encode83(&hash, quantisedMaximum, 1)
+	} else {
+		encode83(&hash, 0, 1)
+	}
+
+	encode83(&hash, linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4)
+
+	for _, factor := range ac {
+		quantise := func(value float64) int {
+			return int(max(0, min(18, math.Floor(signPow(value/maximumValue, 0.5)*9+9.5))))
+		}
+		encode83(&hash, quantise(factor[0])*19*19+quantise(factor[1])*19+quantise(factor[2]), 2)
+	}
+
+	return hash.String(), nil
+}
+
+// encode83 writes the value as length base 83 digits.
+func encode83(hash *strings.Builder, value, length int) {
+	for i := 1; i <= length; i++ {
+		digit := value / int(math.Pow(83, float64(length-i))) % 83
+		hash.WriteByte(characters[digit])
+	}
+}
+
+// sRGBToLinear converts an sRGB channel between 0 and 255 to linear RGB.
+func sRGBToLinear(value uint32) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts a linear RGB channel to sRGB between 0 and 255.
+func linearToSRGB(value float64) int {
+	v := max(0, min(1, value))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+// signPow raises the magnitude of the value to the exponent, keeping its sign.
+func signPow(value, exponent float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exponent), value)
+}
@@ -7,56 +7,161 @@ package data
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-const createBook = `-- name: CreateBook :one
-INSERT INTO books(user_id, name)
-VALUES ($1, $2)
-RETURNING id, created_at, updated_at
+const countBookForUser = `-- name: CountBookForUser :one
+SELECT count(*)
+FROM books
+         CROSS JOIN (SELECT CASE
+                                WHEN $1::text = '' THEN NULL
+                                WHEN $2::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', $1::text))), ' & '))
+                                WHEN $2::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', $1::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', $1::text) END AS query) AS search_query
+WHERE user_id = $3
+  AND deleted_at IS NULL
+  AND ($1::text = '' OR CASE
+                                 WHEN $2::text = 'fuzzy' THEN $1::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = $4::text OR $4::text = '')
+  AND (status = $5::text OR $5::text = '')
+  AND ($6::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = $6))
+  AND (cardinality($7::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY ($7::citext[])) >= CASE WHEN $8::bool THEN cardinality($7::citext[]) ELSE 1 END)
 `
 
-type CreateBookParams struct {
-	UserID uuid.UUID
-	Name   string
+type CountBookForUserParams struct {
+	Search       string
+	SearchMode   string
+	UserID       uuid.UUID
+	Isbn         string
+	Status       string
+	ShelfID      uuid.NullUUID
+	Tags         []string
+	MatchAllTags bool
+}
+
+func (q *Queries) CountBookForUser(ctx context.Context, arg CountBookForUserParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBookForUser,
+		arg.Search,
+		arg.SearchMode,
+		arg.UserID,
+		arg.Isbn,
+		arg.Status,
+		arg.ShelfID,
+		pq.Array(arg.Tags),
+		arg.MatchAllTags,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-type CreateBookRow struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
+const createBook = `-- name: CreateBook :one
+INSERT INTO books(user_id, name, author, isbn, publisher, published_year, page_count, language, genre, status,
+                  current_page, progress_percent, started_at, finished_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+`
+
+type CreateBookParams struct {
+	UserID          uuid.UUID
+	Name            string
+	Author          sql.NullString
+	Isbn            sql.NullString
+	Publisher       sql.NullString
+	PublishedYear   sql.NullInt32
+	PageCount       sql.NullInt32
+	Language        sql.NullString
+	Genre           sql.NullString
+	Status          string
+	CurrentPage     sql.NullInt32
+	ProgressPercent sql.NullInt32
+	StartedAt       sql.NullTime
+	FinishedAt      sql.NullTime
 }
 
-func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (CreateBookRow, error) {
-	row := q.db.QueryRowContext(ctx, createBook, arg.UserID, arg.Name)
-	var i CreateBookRow
-	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
+func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, createBook,
+		arg.UserID,
+		arg.Name,
+		arg.Author,
+		arg.Isbn,
+		arg.Publisher,
+		arg.PublishedYear,
+		arg.PageCount,
+		arg.Language,
+		arg.Genre,
+		arg.Status,
+		arg.CurrentPage,
+		arg.ProgressPercent,
+		arg.StartedAt,
+		arg.FinishedAt,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
 	return i, err
 }
 
-const deleteBook = `-- name: DeleteBook :exec
+const deleteTrashedBook = `-- name: DeleteTrashedBook :exec
 DELETE
 FROM books
 WHERE id = $1
   AND user_id = $2
+  AND deleted_at IS NOT NULL
 `
 
-type DeleteBookParams struct {
+type DeleteTrashedBookParams struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
 }
 
-func (q *Queries) DeleteBook(ctx context.Context, arg DeleteBookParams) error {
-	_, err := q.db.ExecContext(ctx, deleteBook, arg.ID, arg.UserID)
+func (q *Queries) DeleteTrashedBook(ctx context.Context, arg DeleteTrashedBookParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTrashedBook, arg.ID, arg.UserID)
 	return err
 }
 
 const getBook = `-- name: GetBook :one
-SELECT id, user_id, name, created_at, updated_at, version
+SELECT id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
 FROM books
 WHERE id = $1
+  AND deleted_at IS NULL
 `
 
 func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (Book, error) {
@@ -69,44 +174,300 @@ func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (Book, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const getBookForUserByIsbn = `-- name: GetBookForUserByIsbn :one
+SELECT id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+FROM books
+WHERE user_id = $1
+  AND isbn = $2
+  AND deleted_at IS NULL
+`
+
+type GetBookForUserByIsbnParams struct {
+	UserID uuid.UUID
+	Isbn   sql.NullString
+}
+
+func (q *Queries) GetBookForUserByIsbn(ctx context.Context, arg GetBookForUserByIsbnParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, getBookForUserByIsbn, arg.UserID, arg.Isbn)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const getBookForUserByName = `-- name: GetBookForUserByName :one
+SELECT id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+FROM books
+WHERE user_id = $1
+  AND name = $2
+  AND deleted_at IS NULL
+`
+
+type GetBookForUserByNameParams struct {
+	UserID uuid.UUID
+	Name   string
+}
+
+func (q *Queries) GetBookForUserByName(ctx context.Context, arg GetBookForUserByNameParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, getBookForUserByName, arg.UserID, arg.Name)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const getTrashedBook = `-- name: GetTrashedBook :one
+SELECT id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+FROM books
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetTrashedBook(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRowContext(ctx, getTrashedBook, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
 	)
 	return i, err
 }
 
+const listBookForExport = `-- name: ListBookForExport :many
+SELECT id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+FROM books
+WHERE user_id = $1
+  AND deleted_at IS NULL
+  AND (id > $2 OR $2 IS NULL)
+ORDER BY id
+LIMIT $3
+`
+
+type ListBookForExportParams struct {
+	UserID  uuid.UUID
+	AfterID uuid.NullUUID
+	Limit   int32
+}
+
+func (q *Queries) ListBookForExport(ctx context.Context, arg ListBookForExportParams) ([]Book, error) {
+	rows, err := q.db.QueryContext(ctx, listBookForExport, arg.UserID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.Author,
+			&i.Isbn,
+			&i.Publisher,
+			&i.PublishedYear,
+			&i.PageCount,
+			&i.Language,
+			&i.Status,
+			&i.CurrentPage,
+			&i.ProgressPercent,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.Genre,
+			&i.DeletedAt,
+			&i.CoverKey,
+			&i.CoverFormat,
+			&i.CoverBlurhash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBookForUser = `-- name: ListBookForUser :many
 SELECT count(*) OVER () AS total_records,
-       id,
-       user_id,
-       name,
-       created_at,
-       updated_at
+       books.id, books.user_id, books.name, books.created_at, books.updated_at, books.version, books.author, books.isbn, books.publisher, books.published_year, books.page_count, books.language, books.status, books.current_page, books.progress_percent, books.started_at, books.finished_at, books.genre, books.deleted_at, books.cover_key, books.cover_format, books.cover_blurhash,
+       array(SELECT tags.name
+             FROM tags
+                      JOIN book_tags ON book_tags.tag_id = tags.id
+             WHERE book_tags.book_id = books.id
+             ORDER BY tags.name)::text[] AS tags,
+       CASE
+           WHEN $1::text = '' THEN NULL
+           ELSE ts_headline('simple', replace(replace(replace(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search_query.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') END AS headline,
+       search_rank.rank
 FROM books
-WHERE user_id = $1
-  AND (to_tsvector('simple', name) @@ plainto_tsquery('simple', $2) OR $2 = '')
-ORDER BY name
-LIMIT $3 OFFSET $4
+         CROSS JOIN (SELECT CASE
+                                WHEN $1::text = '' THEN NULL
+                                WHEN $2::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', $1::text))), ' & '))
+                                WHEN $2::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', $1::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', $1::text) END AS query) AS search_query
+         CROSS JOIN LATERAL (SELECT CASE
+                                        WHEN $1::text = '' THEN 0
+                                        WHEN $2::text = 'fuzzy' THEN word_similarity($1::text, name)
+                                        ELSE ts_rank(to_tsvector('simple', name), search_query.query) END::float8 AS rank) AS search_rank
+WHERE user_id = $3
+  AND deleted_at IS NULL
+  AND ($1::text = '' OR CASE
+                                 WHEN $2::text = 'fuzzy' THEN $1::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = $4::text OR $4::text = '')
+  AND (status = $5::text OR $5::text = '')
+  AND ($6::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = $6))
+  AND (cardinality($7::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY ($7::citext[])) >= CASE WHEN $8::bool THEN cardinality($7::citext[]) ELSE 1 END)
+ORDER BY CASE WHEN $9::text = 'name' THEN name END,
+         CASE WHEN $9::text = '-name' THEN name END DESC,
+         CASE WHEN $9::text = 'author' THEN author END NULLS LAST,
+         CASE WHEN $9::text = '-author' THEN author END DESC NULLS LAST,
+         CASE WHEN $9::text = 'created_at' THEN created_at END,
+         CASE WHEN $9::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN $9::text = 'updated_at' THEN updated_at END,
+         CASE WHEN $9::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN $9::text = 'rating' THEN (SELECT avg(rating) FROM reviews WHERE reviews.book_id = books.id) END NULLS LAST,
+         CASE WHEN $9::text = '-rating' THEN (SELECT avg(rating) FROM reviews WHERE reviews.book_id = books.id) END DESC NULLS LAST,
+         CASE WHEN $9::text = 'relevance' THEN search_rank.rank END DESC,
+         id
+LIMIT $10 OFFSET $11
 `
 
 type ListBookForUserParams struct {
-	UserID         uuid.UUID
-	PlaintoTsquery string
-	Limit          int32
-	Offset         int32
+	Search       string
+	SearchMode   string
+	UserID       uuid.UUID
+	Isbn         string
+	Status       string
+	ShelfID      uuid.NullUUID
+	Tags         []string
+	MatchAllTags bool
+	Sort         string
+	Limit        int32
+	Offset       int32
 }
 
 type ListBookForUserRow struct {
 	TotalRecords int64
-	ID           uuid.UUID
-	UserID       uuid.UUID
-	Name         string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	Book         Book
+	Tags         []string
+	Headline     sql.NullString
+	Rank         float64
 }
 
 func (q *Queries) ListBookForUser(ctx context.Context, arg ListBookForUserParams) ([]ListBookForUserRow, error) {
 	rows, err := q.db.QueryContext(ctx, listBookForUser,
+		arg.Search,
+		arg.SearchMode,
 		arg.UserID,
-		arg.PlaintoTsquery,
+		arg.Isbn,
+		arg.Status,
+		arg.ShelfID,
+		pq.Array(arg.Tags),
+		arg.MatchAllTags,
+		arg.Sort,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -119,11 +480,31 @@ func (q *Queries) ListBookForUser(ctx context.Context, arg ListBookForUserParams
 		var i ListBookForUserRow
 		if err := rows.Scan(
 			&i.TotalRecords,
-			&i.ID,
-			&i.UserID,
-			&i.Name,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.Book.ID,
+			&i.Book.UserID,
+			&i.Book.Name,
+			&i.Book.CreatedAt,
+			&i.Book.UpdatedAt,
+			&i.Book.Version,
+			&i.Book.Author,
+			&i.Book.Isbn,
+			&i.Book.Publisher,
+			&i.Book.PublishedYear,
+			&i.Book.PageCount,
+			&i.Book.Language,
+			&i.Book.Status,
+			&i.Book.CurrentPage,
+			&i.Book.ProgressPercent,
+			&i.Book.StartedAt,
+			&i.Book.FinishedAt,
+			&i.Book.Genre,
+			&i.Book.DeletedAt,
+			&i.Book.CoverKey,
+			&i.Book.CoverFormat,
+			&i.Book.CoverBlurhash,
+			pq.Array(&i.Tags),
+			&i.Headline,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -138,27 +519,534 @@ func (q *Queries) ListBookForUser(ctx context.Context, arg ListBookForUserParams
 	return items, nil
 }
 
-const updateBook = `-- name: UpdateBook :one
+const listBookForUserByCursor = `-- name: ListBookForUserByCursor :many
+SELECT books.id, books.user_id, books.name, books.created_at, books.updated_at, books.version, books.author, books.isbn, books.publisher, books.published_year, books.page_count, books.language, books.status, books.current_page, books.progress_percent, books.started_at, books.finished_at, books.genre, books.deleted_at, books.cover_key, books.cover_format, books.cover_blurhash,
+       array(SELECT tags.name
+             FROM tags
+                      JOIN book_tags ON book_tags.tag_id = tags.id
+             WHERE book_tags.book_id = books.id
+             ORDER BY tags.name)::text[] AS tags,
+       book_ratings.average_rating,
+       CASE
+           WHEN $1::text = '' THEN NULL
+           ELSE ts_headline('simple', replace(replace(replace(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search_query.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') END AS headline,
+       search_rank.rank
+FROM books
+         CROSS JOIN (SELECT CASE
+                                WHEN $1::text = '' THEN NULL
+                                WHEN $2::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', $1::text))), ' & '))
+                                WHEN $2::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', $1::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', $1::text) END AS query) AS search_query
+         CROSS JOIN LATERAL (SELECT CASE
+                                        WHEN $1::text = '' THEN 0
+                                        WHEN $2::text = 'fuzzy' THEN word_similarity($1::text, name)
+                                        ELSE ts_rank(to_tsvector('simple', name), search_query.query) END::float8 AS rank) AS search_rank
+         LEFT JOIN LATERAL (SELECT avg(reviews.rating) AS average_rating
+                            FROM reviews
+                            WHERE reviews.book_id = books.id) AS book_ratings ON true
+WHERE user_id = $3
+  AND deleted_at IS NULL
+  AND ($1::text = '' OR CASE
+                                 WHEN $2::text = 'fuzzy' THEN $1::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = $4::text OR $4::text = '')
+  AND (status = $5::text OR $5::text = '')
+  AND ($6::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = $6))
+  AND (cardinality($7::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY ($7::citext[])) >= CASE WHEN $8::bool THEN cardinality($7::citext[]) ELSE 1 END)
+  AND ($9::uuid IS NULL OR CASE $10::text
+      WHEN 'name' THEN name > $11::text OR
+                       (name = $11::text AND id > $9)
+      WHEN '-name' THEN name < $11::text OR
+                        (name = $11::text AND id > $9)
+      WHEN 'author' THEN CASE
+                             WHEN $11::text IS NULL THEN author IS NULL AND id > $9
+                             ELSE author > $11::text OR author IS NULL OR
+                                  (author = $11::text AND id > $9) END
+      WHEN '-author' THEN CASE
+                              WHEN $11::text IS NULL THEN author IS NULL AND id > $9
+                              ELSE author < $11::text OR author IS NULL OR
+                                   (author = $11::text AND id > $9) END
+      WHEN 'created_at' THEN created_at > $12::timestamptz OR
+                             (created_at = $12::timestamptz AND id > $9)
+      WHEN '-created_at' THEN created_at < $12::timestamptz OR
+                              (created_at = $12::timestamptz AND id > $9)
+      WHEN 'updated_at' THEN updated_at > $12::timestamptz OR
+                             (updated_at = $12::timestamptz AND id > $9)
+      WHEN '-updated_at' THEN updated_at < $12::timestamptz OR
+                              (updated_at = $12::timestamptz AND id > $9)
+      WHEN 'rating' THEN CASE
+                             WHEN $13::float8 IS NULL
+                                 THEN book_ratings.average_rating IS NULL AND id > $9
+                             ELSE book_ratings.average_rating > $13::float8 OR
+                                  book_ratings.average_rating IS NULL OR
+                                  (book_ratings.average_rating = $13::float8 AND
+                                   id > $9) END
+      WHEN '-rating' THEN CASE
+                              WHEN $13::float8 IS NULL
+                                  THEN book_ratings.average_rating IS NULL AND id > $9
+                              ELSE book_ratings.average_rating < $13::float8 OR
+                                   book_ratings.average_rating IS NULL OR
+                                   (book_ratings.average_rating = $13::float8 AND
+                                    id > $9) END
+      WHEN 'relevance' THEN search_rank.rank < $14::float8 OR
+                            (search_rank.rank = $14::float8 AND id > $9)
+      END)
+ORDER BY CASE WHEN $10::text = 'name' THEN name END,
+         CASE WHEN $10::text = '-name' THEN name END DESC,
+         CASE WHEN $10::text = 'author' THEN author END NULLS LAST,
+         CASE WHEN $10::text = '-author' THEN author END DESC NULLS LAST,
+         CASE WHEN $10::text = 'created_at' THEN created_at END,
+         CASE WHEN $10::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN $10::text = 'updated_at' THEN updated_at END,
+         CASE WHEN $10::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN $10::text = 'rating' THEN book_ratings.average_rating END NULLS LAST,
+         CASE WHEN $10::text = '-rating' THEN book_ratings.average_rating END DESC NULLS LAST,
+         CASE WHEN $10::text = 'relevance' THEN search_rank.rank END DESC,
+         id
+LIMIT $15
+`
+
+type ListBookForUserByCursorParams struct {
+	Search       string
+	SearchMode   string
+	UserID       uuid.UUID
+	Isbn         string
+	Status       string
+	ShelfID      uuid.NullUUID
+	Tags         []string
+	MatchAllTags bool
+	CursorID     uuid.NullUUID
+	Sort         string
+	CursorText   sql.NullString
+	CursorTime   sql.NullTime
+	CursorRating sql.NullFloat64
+	CursorRank   sql.NullFloat64
+	Limit        int32
+}
+
+type ListBookForUserByCursorRow struct {
+	Book          Book
+	Tags          []string
+	AverageRating sql.NullFloat64
+	Headline      sql.NullString
+	Rank          float64
+}
+
+func (q *Queries) ListBookForUserByCursor(ctx context.Context, arg ListBookForUserByCursorParams) ([]ListBookForUserByCursorRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBookForUserByCursor,
+		arg.Search,
+		arg.SearchMode,
+		arg.UserID,
+		arg.Isbn,
+		arg.Status,
+		arg.ShelfID,
+		pq.Array(arg.Tags),
+		arg.MatchAllTags,
+		arg.CursorID,
+		arg.Sort,
+		arg.CursorText,
+		arg.CursorTime,
+		arg.CursorRating,
+		arg.CursorRank,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBookForUserByCursorRow
+	for rows.Next() {
+		var i ListBookForUserByCursorRow
+		if err := rows.Scan(
+			&i.Book.ID,
+			&i.Book.UserID,
+			&i.Book.Name,
+			&i.Book.CreatedAt,
+			&i.Book.UpdatedAt,
+			&i.Book.Version,
+			&i.Book.Author,
+			&i.Book.Isbn,
+			&i.Book.Publisher,
+			&i.Book.PublishedYear,
+			&i.Book.PageCount,
+			&i.Book.Language,
+			&i.Book.Status,
+			&i.Book.CurrentPage,
+			&i.Book.ProgressPercent,
+			&i.Book.StartedAt,
+			&i.Book.FinishedAt,
+			&i.Book.Genre,
+			&i.Book.DeletedAt,
+			&i.Book.CoverKey,
+			&i.Book.CoverFormat,
+			&i.Book.CoverBlurhash,
+			pq.Array(&i.Tags),
+			&i.AverageRating,
+			&i.Headline,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedBookForUser = `-- name: ListTrashedBookForUser :many
+SELECT count(*) OVER () AS total_records,
+       books.id, books.user_id, books.name, books.created_at, books.updated_at, books.version, books.author, books.isbn, books.publisher, books.published_year, books.page_count, books.language, books.status, books.current_page, books.progress_percent, books.started_at, books.finished_at, books.genre, books.deleted_at, books.cover_key, books.cover_format, books.cover_blurhash
+FROM books
+WHERE user_id = $1
+  AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT $2 OFFSET $3
+`
+
+type ListTrashedBookForUserParams struct {
+	UserID uuid.UUID
+	Limit  int32
+	Offset int32
+}
+
+type ListTrashedBookForUserRow struct {
+	TotalRecords int64
+	Book         Book
+}
+
+func (q *Queries) ListTrashedBookForUser(ctx context.Context, arg ListTrashedBookForUserParams) ([]ListTrashedBookForUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTrashedBookForUser, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrashedBookForUserRow
+	for rows.Next() {
+		var i ListTrashedBookForUserRow
+		if err := rows.Scan(
+			&i.TotalRecords,
+			&i.Book.ID,
+			&i.Book.UserID,
+			&i.Book.Name,
+			&i.Book.CreatedAt,
+			&i.Book.UpdatedAt,
+			&i.Book.Version,
+			&i.Book.Author,
+			&i.Book.Isbn,
+			&i.Book.Publisher,
+			&i.Book.PublishedYear,
+			&i.Book.PageCount,
+			&i.Book.Language,
+			&i.Book.Status,
+			&i.Book.CurrentPage,
+			&i.Book.ProgressPercent,
+			&i.Book.StartedAt,
+			&i.Book.FinishedAt,
+			&i.Book.Genre,
+			&i.Book.DeletedAt,
+			&i.Book.CoverKey,
+			&i.Book.CoverFormat,
+			&i.Book.CoverBlurhash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTrashedBooks = `-- name: PurgeTrashedBooks :many
+DELETE
+FROM books
+WHERE deleted_at < $1::timestamptz
+RETURNING cover_key, cover_format
+`
+
+type PurgeTrashedBooksRow struct {
+	CoverKey    sql.NullString
+	CoverFormat sql.NullString
+}
+
+func (q *Queries) PurgeTrashedBooks(ctx context.Context, trashedBefore time.Time) ([]PurgeTrashedBooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, purgeTrashedBooks, trashedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeTrashedBooksRow
+	for rows.Next() {
+		var i PurgeTrashedBooksRow
+		if err := rows.Scan(&i.CoverKey, &i.CoverFormat); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreBook = `-- name: RestoreBook :one
+UPDATE books
+SET deleted_at = NULL
+WHERE id = $1
+  AND user_id = $2
+  AND deleted_at IS NOT NULL
+RETURNING id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+`
+
+type RestoreBookParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) RestoreBook(ctx context.Context, arg RestoreBookParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, restoreBook, arg.ID, arg.UserID)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const trashBook = `-- name: TrashBook :execrows
 UPDATE books
-SET name       = $1,
-    updated_at = now(),
-    version    = version + 1
-WHERE id = $2
+SET deleted_at = now()
+WHERE id = $1
+  AND user_id = $2
   AND version = $3
-  AND user_id = $4
-RETURNING id, user_id, name, created_at, updated_at, version
+  AND deleted_at IS NULL
 `
 
-type UpdateBookParams struct {
-	Name    string
+type TrashBookParams struct {
 	ID      uuid.UUID
-	Version int32
 	UserID  uuid.UUID
+	Version int32
+}
+
+func (q *Queries) TrashBook(ctx context.Context, arg TrashBookParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, trashBook, arg.ID, arg.UserID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateBook = `-- name: UpdateBook :one
+UPDATE books
+SET name           = $1,
+    author         = $2,
+    isbn           = $3,
+    publisher      = $4,
+    published_year = $5,
+    page_count     = $6,
+    language       = $7,
+    genre          = $8,
+    updated_at     = now(),
+    version        = version + 1
+WHERE id = $9
+  AND version = $10
+  AND user_id = $11
+  AND deleted_at IS NULL
+RETURNING id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+`
+
+type UpdateBookParams struct {
+	Name          string
+	Author        sql.NullString
+	Isbn          sql.NullString
+	Publisher     sql.NullString
+	PublishedYear sql.NullInt32
+	PageCount     sql.NullInt32
+	Language      sql.NullString
+	Genre         sql.NullString
+	ID            uuid.UUID
+	Version       int32
+	UserID        uuid.UUID
 }
 
 func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error) {
 	row := q.db.QueryRowContext(ctx, updateBook,
 		arg.Name,
+		arg.Author,
+		arg.Isbn,
+		arg.Publisher,
+		arg.PublishedYear,
+		arg.PageCount,
+		arg.Language,
+		arg.Genre,
+		arg.ID,
+		arg.Version,
+		arg.UserID,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const updateBookCover = `-- name: UpdateBookCover :one
+UPDATE books
+SET cover_key      = $1,
+    cover_format   = $2,
+    cover_blurhash = $3,
+    updated_at     = now(),
+    version        = version + 1
+WHERE id = $4
+  AND version = $5
+  AND user_id = $6
+  AND deleted_at IS NULL
+RETURNING id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+`
+
+type UpdateBookCoverParams struct {
+	CoverKey      sql.NullString
+	CoverFormat   sql.NullString
+	CoverBlurhash sql.NullString
+	ID            uuid.UUID
+	Version       int32
+	UserID        uuid.UUID
+}
+
+func (q *Queries) UpdateBookCover(ctx context.Context, arg UpdateBookCoverParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, updateBookCover,
+		arg.CoverKey,
+		arg.CoverFormat,
+		arg.CoverBlurhash,
+		arg.ID,
+		arg.Version,
+		arg.UserID,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
+	)
+	return i, err
+}
+
+const updateReadingProgress = `-- name: UpdateReadingProgress :one
+UPDATE books
+SET status           = $1,
+    current_page     = $2,
+    progress_percent = $3,
+    started_at       = $4,
+    finished_at      = $5,
+    updated_at       = now(),
+    version          = version + 1
+WHERE id = $6
+  AND version = $7
+  AND user_id = $8
+  AND deleted_at IS NULL
+RETURNING id, user_id, name, created_at, updated_at, version, author, isbn, publisher, published_year, page_count, language, status, current_page, progress_percent, started_at, finished_at, genre, deleted_at, cover_key, cover_format, cover_blurhash
+`
+
+type UpdateReadingProgressParams struct {
+	Status          string
+	CurrentPage     sql.NullInt32
+	ProgressPercent sql.NullInt32
+	StartedAt       sql.NullTime
+	FinishedAt      sql.NullTime
+	ID              uuid.UUID
+	Version         int32
+	UserID          uuid.UUID
+}
+
+func (q *Queries) UpdateReadingProgress(ctx context.Context, arg UpdateReadingProgressParams) (Book, error) {
+	row := q.db.QueryRowContext(ctx, updateReadingProgress,
+		arg.Status,
+		arg.CurrentPage,
+		arg.ProgressPercent,
+		arg.StartedAt,
+		arg.FinishedAt,
 		arg.ID,
 		arg.Version,
 		arg.UserID,
@@ -171,6 +1059,22 @@ func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Version,
+		&i.Author,
+		&i.Isbn,
+		&i.Publisher,
+		&i.PublishedYear,
+		&i.PageCount,
+		&i.Language,
+		&i.Status,
+		&i.CurrentPage,
+		&i.ProgressPercent,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Genre,
+		&i.DeletedAt,
+		&i.CoverKey,
+		&i.CoverFormat,
+		&i.CoverBlurhash,
 	)
 	return i, err
 }
@@ -5,26 +5,158 @@
 package data
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Book struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int32
+	Author          sql.NullString
+	Isbn            sql.NullString
+	Publisher       sql.NullString
+	PublishedYear   sql.NullInt32
+	PageCount       sql.NullInt32
+	Language        sql.NullString
+	Status          string
+	CurrentPage     sql.NullInt32
+	ProgressPercent sql.NullInt32
+	StartedAt       sql.NullTime
+	FinishedAt      sql.NullTime
+	Genre           sql.NullString
+	DeletedAt       sql.NullTime
+	CoverKey        sql.NullString
+	CoverFormat     sql.NullString
+	CoverBlurhash   sql.NullString
+}
+
+type BookRevision struct {
 	ID        uuid.UUID
+	BookID    uuid.UUID
 	UserID    uuid.UUID
-	Name      string
+	Version   int32
+	Action    string
+	Changes   json.RawMessage
+	Snapshot  json.RawMessage
+	CreatedAt time.Time
+}
+
+type BookTag struct {
+	BookID uuid.UUID
+	TagID  uuid.UUID
+}
+
+type Export struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Format     string
+	Status     string
+	Size       sql.NullInt64
+	Error      sql.NullString
+	CreatedAt  time.Time
+	FinishedAt sql.NullTime
+}
+
+type Goal struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Year        int32
+	Kind        string
+	Target      int32
+	CompletedAt sql.NullTime
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int32
+}
+
+type Import struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Source       string
+	Status       string
+	RowsTotal    int32
+	RowsImported int32
+	Skipped      json.RawMessage
+	Failed       json.RawMessage
+	Error        sql.NullString
+	CreatedAt    time.Time
+	FinishedAt   sql.NullTime
+}
+
+type Note struct {
+	ID            uuid.UUID
+	BookID        uuid.UUID
+	UserID        uuid.UUID
+	Kind          string
+	Body          string
+	Page          sql.NullInt32
+	Chapter       sql.NullString
+	LocationStart sql.NullInt32
+	LocationEnd   sql.NullInt32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Version       int32
+}
+
+type ReadingSession struct {
+	ID        uuid.UUID
+	BookID    uuid.UUID
+	UserID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   time.Time
+	PagesRead int32
+	CreatedAt time.Time
+}
+
+type Review struct {
+	ID        uuid.UUID
+	BookID    uuid.UUID
+	UserID    uuid.UUID
+	Rating    float64
+	Body      sql.NullString
+	Spoiler   bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Version   int32
 }
 
+type Shelf struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Name        string
+	Description sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int32
+}
+
+type ShelfBook struct {
+	ShelfID uuid.UUID
+	BookID  uuid.UUID
+	AddedAt time.Time
+}
+
+type Tag struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
 type User struct {
-	ID            uuid.UUID
-	FirstName     string
-	LastName      string
-	Email         string
-	EmailVerified bool
-	PasswordHash  []byte
-	CreatedAt     time.Time
+	ID                uuid.UUID
+	FirstName         string
+	LastName          string
+	Email             string
+	EmailVerified     bool
+	PasswordHash      []byte
+	CreatedAt         time.Time
+	LibraryModifiedAt time.Time
 }
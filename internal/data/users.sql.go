@@ -44,7 +44,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 }
 
 const findUserByEmail = `-- name: FindUserByEmail :one
-SELECT id, first_name, last_name, email, email_verified, password_hash, created_at
+SELECT id, first_name, last_name, email, email_verified, password_hash, created_at, library_modified_at
 FROM users
 WHERE email = $1
 `
@@ -60,12 +60,26 @@ func (q *Queries) FindUserByEmail(ctx context.Context, email string) (User, erro
 		&i.EmailVerified,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.LibraryModifiedAt,
 	)
 	return i, err
 }
 
+const getLibraryModifiedAt = `-- name: GetLibraryModifiedAt :one
+SELECT library_modified_at
+FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetLibraryModifiedAt(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getLibraryModifiedAt, id)
+	var library_modified_at time.Time
+	err := row.Scan(&library_modified_at)
+	return library_modified_at, err
+}
+
 const getUser = `-- name: GetUser :one
-SELECT id, first_name, last_name, email, email_verified, password_hash, created_at
+SELECT id, first_name, last_name, email, email_verified, password_hash, created_at, library_modified_at
 FROM users
 WHERE id = $1
 `
@@ -81,6 +95,7 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.EmailVerified,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.LibraryModifiedAt,
 	)
 	return i, err
 }
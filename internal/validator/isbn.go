@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidISBN is returned when converting an ISBN that fails checksum validation.
+var ErrInvalidISBN = errors.New("invalid isbn")
+
+// NormalizeISBN strips hyphens and spaces from an ISBN and upper-cases
+// the ISBN-10 check character, e.g. "0-306-40615-x" becomes "030640615X".
+func NormalizeISBN(isbn string) string {
+	var b strings.Builder
+	for _, r := range isbn {
+		switch r {
+		case '-', ' ':
+			continue
+		case 'x':
+			b.WriteRune('X')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// ValidISBN10 returns true if the value is an ISBN-10 with a valid checksum.
+func ValidISBN10(isbn string) bool {
+	isbn = NormalizeISBN(isbn)
+	if len(isbn) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var digit int
+		switch {
+		case isbn[i] >= '0' && isbn[i] <= '9':
+			digit = int(isbn[i] - '0')
+		case isbn[i] == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * digit
+	}
+
+	return sum%11 == 0
+}
+
+// ValidISBN13 returns true if the value is an ISBN-13 with a valid checksum.
+func ValidISBN13(isbn string) bool {
+	isbn = NormalizeISBN(isbn)
+	if len(isbn) != 13 || !isDigits(isbn) {
+		return false
+	}
+
+	return isbn13CheckDigit(isbn[:12]) == isbn[12]
+}
+
+// ValidISBN returns true if the value is either a valid ISBN-10 or a valid ISBN-13.
+func ValidISBN(isbn string) bool {
+	return ValidISBN10(isbn) || ValidISBN13(isbn)
+}
+
+// ISBN10To13 converts a valid ISBN-10 to its ISBN-13 equivalent by adding
+// the "978" prefix and recalculating the check digit.
+func ISBN10To13(isbn string) (string, error) {
+	if !ValidISBN10(isbn) {
+		return "", ErrInvalidISBN
+	}
+
+	body := "978" + NormalizeISBN(isbn)[:9]
+	return body + string(isbn13CheckDigit(body)), nil
+}
+
+// ISBN13To10 converts a valid ISBN-13 to its ISBN-10 equivalent. Only
+// ISBN-13 values with the "978" prefix have an ISBN-10 equivalent.
+func ISBN13To10(isbn string) (string, error) {
+	isbn = NormalizeISBN(isbn)
+	if !ValidISBN13(isbn) || !strings.HasPrefix(isbn, "978") {
+		return "", ErrInvalidISBN
+	}
+
+	body := isbn[3:12]
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(body[i]-'0')
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return body + "X", nil
+	}
+	return body + string(rune('0'+check)), nil
+}
+
+// isbn13CheckDigit calculates the check digit for the first 12 digits of an ISBN-13.
+func isbn13CheckDigit(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(body[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// isDigits returns true if the value only contains the digits 0-9.
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
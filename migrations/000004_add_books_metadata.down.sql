@@ -0,0 +1,7 @@
+ALTER TABLE books
+    DROP COLUMN IF EXISTS author,
+    DROP COLUMN IF EXISTS isbn,
+    DROP COLUMN IF EXISTS publisher,
+    DROP COLUMN IF EXISTS published_year,
+    DROP COLUMN IF EXISTS page_count,
+    DROP COLUMN IF EXISTS language;
\ No newline at end of file
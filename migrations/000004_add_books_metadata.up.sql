@@ -0,0 +1,7 @@
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS author         text,
+    ADD COLUMN IF NOT EXISTS isbn           text,
+    ADD COLUMN IF NOT EXISTS publisher      text,
+    ADD COLUMN IF NOT EXISTS published_year int CHECK (published_year > 0),
+    ADD COLUMN IF NOT EXISTS page_count     int CHECK (page_count > 0),
+    ADD COLUMN IF NOT EXISTS language       text;
\ No newline at end of file
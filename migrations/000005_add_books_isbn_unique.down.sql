@@ -0,0 +1,2 @@
+ALTER TABLE books
+    DROP CONSTRAINT IF EXISTS books_user_id_isbn_key;
\ No newline at end of file
@@ -0,0 +1,27 @@
+UPDATE books
+SET isbn = upper(regexp_replace(isbn, '[\s-]', '', 'g'))
+WHERE isbn IS NOT NULL;
+
+-- Valid ISBN-10s are stored as their ISBN-13 equivalent, in the same way as the
+-- ISBNs the API writes, so that both forms of an ISBN match the same book.
+UPDATE books
+SET isbn = '978' || left(isbn, 9) || ((10 - (SELECT sum(substr('978' || left(isbn, 9), i, 1)::int * (CASE WHEN i % 2 = 0 THEN 3 ELSE 1 END))
+                                             FROM generate_series(1, 12) AS i) % 10) % 10)::text
+WHERE isbn ~ '^[0-9]{9}[0-9X]$'
+  AND (SELECT sum((11 - i) * (CASE WHEN substr(isbn, i, 1) = 'X' THEN 10 ELSE substr(isbn, i, 1)::int END))
+       FROM generate_series(1, 10) AS i) % 11 = 0;
+
+-- Books of a user that now share an ISBN keep it on the oldest of them only, as
+-- the constraint could not be added otherwise. The other books are kept without
+-- their ISBN.
+UPDATE books
+SET isbn = NULL
+WHERE id IN (SELECT id
+             FROM (SELECT id,
+                          row_number() OVER (PARTITION BY user_id, isbn ORDER BY created_at, id) AS duplicate
+                   FROM books
+                   WHERE isbn IS NOT NULL) AS numbered
+             WHERE duplicate > 1);
+
+ALTER TABLE books
+    ADD CONSTRAINT books_user_id_isbn_key UNIQUE (user_id, isbn);
\ No newline at end of file
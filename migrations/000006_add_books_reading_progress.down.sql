@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS books_user_id_status_idx;
+
+ALTER TABLE books
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS current_page,
+    DROP COLUMN IF EXISTS progress_percent,
+    DROP COLUMN IF EXISTS started_at,
+    DROP COLUMN IF EXISTS finished_at;
\ No newline at end of file
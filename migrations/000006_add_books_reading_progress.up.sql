@@ -0,0 +1,9 @@
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS status           text NOT NULL DEFAULT 'want_to_read'
+        CHECK (status IN ('want_to_read', 'reading', 'finished', 'abandoned')),
+    ADD COLUMN IF NOT EXISTS current_page     int CHECK (current_page >= 0),
+    ADD COLUMN IF NOT EXISTS progress_percent int CHECK (progress_percent BETWEEN 0 AND 100),
+    ADD COLUMN IF NOT EXISTS started_at       timestamp(0) WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS finished_at      timestamp(0) WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS books_user_id_status_idx ON books (user_id, status);
\ No newline at end of file
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS shelves
+(
+    id          uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    user_id     uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name        text                        NOT NULL,
+    description text,
+    created_at  timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at  timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    version     int                         NOT NULL DEFAULT 1,
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS shelf_books
+(
+    shelf_id uuid                        NOT NULL REFERENCES shelves (id) ON DELETE CASCADE,
+    book_id  uuid                        NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    added_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (shelf_id, book_id)
+);
+
+CREATE INDEX IF NOT EXISTS shelf_books_book_id_idx ON shelf_books (book_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS book_tags;
+DROP TABLE IF EXISTS tags;
\ No newline at end of file
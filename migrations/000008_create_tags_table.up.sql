@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS tags
+(
+    id         uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    user_id    uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name       citext                      NOT NULL,
+    created_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS book_tags
+(
+    book_id uuid NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    tag_id  uuid NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (book_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS book_tags_tag_id_idx ON book_tags (tag_id);
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS reviews
+(
+    id         uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    book_id    uuid                        NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    user_id    uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    rating     double precision            NOT NULL CHECK (rating BETWEEN 1 AND 5 AND rating * 2 = trunc(rating * 2)),
+    body       text,
+    spoiler    boolean                     NOT NULL DEFAULT false,
+    created_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    version    int                         NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS reviews_book_id_idx ON reviews (book_id);
\ No newline at end of file
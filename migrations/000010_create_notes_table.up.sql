@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS notes
+(
+    id             uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    book_id        uuid                        NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    user_id        uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind           text                        NOT NULL DEFAULT 'note' CHECK (kind IN ('note', 'quote', 'highlight')),
+    body           text                        NOT NULL,
+    page           int CHECK (page > 0),
+    chapter        text,
+    location_start int CHECK (location_start >= 0),
+    location_end   int,
+    created_at     timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at     timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    version        int                         NOT NULL DEFAULT 1,
+    CHECK (location_end IS NULL OR (location_start IS NOT NULL AND location_end >= location_start))
+);
+
+CREATE INDEX IF NOT EXISTS notes_book_id_idx ON notes (book_id);
+CREATE INDEX IF NOT EXISTS notes_body_idx ON notes USING GIN (to_tsvector('simple', body));
\ No newline at end of file
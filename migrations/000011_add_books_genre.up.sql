@@ -0,0 +1,2 @@
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS genre text;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS reading_sessions
+(
+    id         uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    book_id    uuid                        NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    user_id    uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    started_at timestamp(0) WITH TIME ZONE NOT NULL,
+    ended_at   timestamp(0) WITH TIME ZONE NOT NULL,
+    pages_read int                         NOT NULL DEFAULT 0 CHECK (pages_read >= 0),
+    created_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    CHECK (ended_at > started_at)
+);
+
+CREATE INDEX IF NOT EXISTS reading_sessions_book_id_idx ON reading_sessions (book_id);
+CREATE INDEX IF NOT EXISTS reading_sessions_user_id_started_at_idx ON reading_sessions (user_id, started_at);
\ No newline at end of file
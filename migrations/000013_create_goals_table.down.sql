@@ -0,0 +1 @@
+DROP TABLE IF EXISTS goals;
\ No newline at end of file
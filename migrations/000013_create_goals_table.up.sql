@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS goals
+(
+    id           uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    user_id      uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    year         int                         NOT NULL CHECK (year > 0),
+    kind         text                        NOT NULL CHECK (kind IN ('books', 'pages')),
+    target       int                         NOT NULL CHECK (target > 0),
+    completed_at timestamp(0) WITH TIME ZONE,
+    created_at   timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at   timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    version      int                         NOT NULL DEFAULT 1,
+    UNIQUE (user_id, year, kind)
+);
\ No newline at end of file
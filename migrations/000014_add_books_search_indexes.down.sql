@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS books_name_trgm_idx;
+DROP INDEX IF EXISTS books_name_search_idx;
+
+DROP EXTENSION IF EXISTS pg_trgm;
\ No newline at end of file
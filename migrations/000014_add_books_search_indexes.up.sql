@@ -0,0 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS books_name_search_idx ON books USING GIN (to_tsvector('simple', name));
+CREATE INDEX IF NOT EXISTS books_name_trgm_idx ON books USING GIN (name gin_trgm_ops);
\ No newline at end of file
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS books_author_trgm_idx ON books USING GIN (author gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS tags_name_trgm_idx ON tags USING GIN ((name::text) gin_trgm_ops);
\ No newline at end of file
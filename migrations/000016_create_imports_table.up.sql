@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS imports
+(
+    id            uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    user_id       uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    source        text                        NOT NULL,
+    status        text                        NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    rows_total    int                         NOT NULL DEFAULT 0,
+    rows_imported int                         NOT NULL DEFAULT 0,
+    skipped       jsonb                       NOT NULL DEFAULT '[]',
+    failed        jsonb                       NOT NULL DEFAULT '[]',
+    error         text,
+    created_at    timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    finished_at   timestamp(0) WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS imports_user_id_idx ON imports (user_id);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DELETE
+FROM notes
+WHERE kind = 'bookmark';
+
+ALTER TABLE notes
+    DROP CONSTRAINT IF EXISTS notes_kind_check,
+    ADD CONSTRAINT notes_kind_check CHECK (kind IN ('note', 'quote', 'highlight'));
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE notes
+    DROP CONSTRAINT IF EXISTS notes_kind_check,
+    ADD CONSTRAINT notes_kind_check CHECK (kind IN ('note', 'quote', 'highlight', 'bookmark'));
\ No newline at end of file
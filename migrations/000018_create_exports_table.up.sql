@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS exports
+(
+    id          uuid PRIMARY KEY                     DEFAULT gen_random_uuid(),
+    user_id     uuid                        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    format      text                        NOT NULL CHECK (format IN ('json', 'csv', 'goodreads')),
+    status      text                        NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    size        bigint,
+    error       text,
+    created_at  timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now(),
+    finished_at timestamp(0) WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS exports_user_id_idx ON exports (user_id);
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- The books in the trash would have to be deleted along with their tags, reviews
+-- and notes for the column to be dropped, so the migration refuses to run until
+-- they have been restored or deleted.
+DO
+$$
+DECLARE
+    trashed bigint;
+BEGIN
+    SELECT count(*) INTO trashed FROM books WHERE deleted_at IS NOT NULL;
+    IF trashed > 0 THEN
+        RAISE EXCEPTION 'the trash must be emptied before migrating down, % books are in the trash', trashed;
+    END IF;
+END
+$$;
+
+DROP INDEX IF EXISTS books_deleted_at_idx;
+DROP INDEX IF EXISTS books_user_id_name_key;
+DROP INDEX IF EXISTS books_user_id_isbn_key;
+
+ALTER TABLE books
+    ADD CONSTRAINT books_user_id_name_key UNIQUE (user_id, name),
+    ADD CONSTRAINT books_user_id_isbn_key UNIQUE (user_id, isbn);
+
+ALTER TABLE books
+    DROP COLUMN IF EXISTS deleted_at;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp(0) WITH TIME ZONE;
+
+-- Trashed books no longer count towards the unique names and ISBNs of a user,
+-- so that a trashed book can be added again. The indexes keep the names of the
+-- constraints they replace.
+ALTER TABLE books
+    DROP CONSTRAINT IF EXISTS books_user_id_name_key,
+    DROP CONSTRAINT IF EXISTS books_user_id_isbn_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS books_user_id_name_key ON books (user_id, name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS books_user_id_isbn_key ON books (user_id, isbn) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS books_deleted_at_idx ON books (deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
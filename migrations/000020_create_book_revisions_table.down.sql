@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS books_record_revision ON books;
+DROP FUNCTION IF EXISTS record_book_revision();
+DROP TABLE IF EXISTS book_revisions;
\ No newline at end of file
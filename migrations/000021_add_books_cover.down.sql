@@ -0,0 +1,42 @@
+-- record_book_revision records every change of a book along with the fields
+-- that changed, from their old to their new value, and a snapshot of the book.
+-- Moving a book to the trash and out of it are recorded as its delete and its
+-- restore, and an update that changed none of the fields is not recorded.
+CREATE OR REPLACE FUNCTION record_book_revision() RETURNS trigger AS
+$$
+DECLARE
+    revision_action  text;
+    revision_changes jsonb;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        revision_action := 'create';
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        revision_action := 'delete';
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        revision_action := 'restore';
+    ELSE
+        revision_action := 'update';
+    END IF;
+
+    SELECT coalesce(jsonb_object_agg(new_fields.key, jsonb_build_object('from', old_fields.value, 'to', new_fields.value)), '{}')
+    INTO revision_changes
+    FROM jsonb_each(to_jsonb(NEW)) AS new_fields
+             LEFT JOIN jsonb_each(coalesce(to_jsonb(OLD), '{}')) AS old_fields ON old_fields.key = new_fields.key
+    WHERE new_fields.key NOT IN ('id', 'user_id', 'created_at', 'updated_at', 'version', 'deleted_at')
+      AND coalesce(old_fields.value, 'null') IS DISTINCT FROM new_fields.value;
+
+    IF revision_action = 'update' AND revision_changes = '{}' THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO book_revisions(book_id, user_id, version, action, changes, snapshot)
+    VALUES (NEW.id, NEW.user_id, NEW.version, revision_action, revision_changes, to_jsonb(NEW));
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE books
+    DROP COLUMN IF EXISTS cover_key,
+    DROP COLUMN IF EXISTS cover_format,
+    DROP COLUMN IF EXISTS cover_blurhash;
\ No newline at end of file
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS reviews_touch_book ON reviews;
+DROP TRIGGER IF EXISTS tags_touch_book ON tags;
+DROP TRIGGER IF EXISTS book_tags_touch_book ON book_tags;
+DROP TRIGGER IF EXISTS shelf_books_touch_library ON shelf_books;
+DROP TRIGGER IF EXISTS books_touch_library ON books;
+
+DROP FUNCTION IF EXISTS touch_book();
+DROP FUNCTION IF EXISTS touch_library();
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS library_modified_at;
\ No newline at end of file
@@ -0,0 +1,78 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS library_modified_at timestamp(0) WITH TIME ZONE NOT NULL DEFAULT now();
+
+-- touch_library records that the library of the owner of a book changed, which
+-- is any change to the books of the user or to the shelves they are on. The
+-- update is skipped once the transaction has made it, so that an import writing
+-- many books updates the user once. The column keeps whole seconds, so it is
+-- compared with now() rounded in the same way.
+CREATE OR REPLACE FUNCTION touch_library() RETURNS trigger AS
+$$
+DECLARE
+    library_user_id uuid;
+BEGIN
+    IF TG_TABLE_NAME = 'books' THEN
+        library_user_id := coalesce(NEW.user_id, OLD.user_id);
+    ELSIF TG_OP = 'DELETE' THEN
+        SELECT user_id INTO library_user_id FROM shelves WHERE id = OLD.shelf_id;
+    ELSE
+        SELECT user_id INTO library_user_id FROM shelves WHERE id = NEW.shelf_id;
+    END IF;
+
+    UPDATE users
+    SET library_modified_at = now()
+    WHERE id = library_user_id
+      AND library_modified_at IS DISTINCT FROM now()::timestamp(0) WITH TIME ZONE;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- touch_book records that a book changed when its tags or reviews change, as
+-- they are part of the book as the API returns it.
+CREATE OR REPLACE FUNCTION touch_book() RETURNS trigger AS
+$$
+BEGIN
+    IF TG_TABLE_NAME = 'tags' THEN
+        UPDATE books
+        SET updated_at = now()
+        WHERE id IN (SELECT book_id FROM book_tags WHERE tag_id = NEW.id);
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE books SET updated_at = now() WHERE id = OLD.book_id;
+    ELSE
+        UPDATE books SET updated_at = now() WHERE id = NEW.book_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER books_touch_library
+    AFTER INSERT OR UPDATE OR DELETE
+    ON books
+    FOR EACH ROW
+EXECUTE FUNCTION touch_library();
+
+CREATE TRIGGER shelf_books_touch_library
+    AFTER INSERT OR DELETE
+    ON shelf_books
+    FOR EACH ROW
+EXECUTE FUNCTION touch_library();
+
+CREATE TRIGGER book_tags_touch_book
+    AFTER INSERT OR DELETE
+    ON book_tags
+    FOR EACH ROW
+EXECUTE FUNCTION touch_book();
+
+CREATE TRIGGER tags_touch_book
+    AFTER UPDATE OF name
+    ON tags
+    FOR EACH ROW
+EXECUTE FUNCTION touch_book();
+
+CREATE TRIGGER reviews_touch_book
+    AFTER INSERT OR UPDATE OR DELETE
+    ON reviews
+    FOR EACH ROW
+EXECUTE FUNCTION touch_book();
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- name: ListBookRevisionForBook :many
+SELECT count(*) OVER () AS total_records,
+       sqlc.embed(book_revisions)
+FROM book_revisions
+WHERE book_id = @book_id
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetBookRevision :one
+SELECT *
+FROM book_revisions
+WHERE id = $1;
\ No newline at end of file
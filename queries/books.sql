@@ -1,38 +1,303 @@
+-- name: CountBookForUser :one
+SELECT count(*)
+FROM books
+         CROSS JOIN (SELECT CASE
+                                WHEN @search::text = '' THEN NULL
+                                WHEN @search_mode::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', @search::text))), ' & '))
+                                WHEN @search_mode::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', @search::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', @search::text) END AS query) AS search_query
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND (@search::text = '' OR CASE
+                                 WHEN @search_mode::text = 'fuzzy' THEN @search::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = @isbn::text OR @isbn::text = '')
+  AND (status = @status::text OR @status::text = '')
+  AND (sqlc.narg('shelf_id')::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = sqlc.narg('shelf_id')))
+  AND (cardinality(@tags::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY (@tags::citext[])) >= CASE WHEN @match_all_tags::bool THEN cardinality(@tags::citext[]) ELSE 1 END);
+
 -- name: CreateBook :one
-INSERT INTO books(user_id, name)
-VALUES ($1, $2)
-RETURNING id, created_at, updated_at;
+INSERT INTO books(user_id, name, author, isbn, publisher, published_year, page_count, language, genre, status,
+                  current_page, progress_percent, started_at, finished_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
 
 -- name: ListBookForUser :many
 SELECT count(*) OVER () AS total_records,
-       id,
-       user_id,
-       name,
-       created_at,
-       updated_at
+       sqlc.embed(books),
+       array(SELECT tags.name
+             FROM tags
+                      JOIN book_tags ON book_tags.tag_id = tags.id
+             WHERE book_tags.book_id = books.id
+             ORDER BY tags.name)::text[] AS tags,
+       CASE
+           WHEN @search::text = '' THEN NULL
+           ELSE ts_headline('simple', replace(replace(replace(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search_query.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') END AS headline,
+       search_rank.rank
 FROM books
-WHERE user_id = $1
-  AND (to_tsvector('simple', name) @@ plainto_tsquery('simple', $2) OR $2 = '')
-ORDER BY name
-LIMIT $3 OFFSET $4;
+         CROSS JOIN (SELECT CASE
+                                WHEN @search::text = '' THEN NULL
+                                WHEN @search_mode::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', @search::text))), ' & '))
+                                WHEN @search_mode::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', @search::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', @search::text) END AS query) AS search_query
+         CROSS JOIN LATERAL (SELECT CASE
+                                        WHEN @search::text = '' THEN 0
+                                        WHEN @search_mode::text = 'fuzzy' THEN word_similarity(@search::text, name)
+                                        ELSE ts_rank(to_tsvector('simple', name), search_query.query) END::float8 AS rank) AS search_rank
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND (@search::text = '' OR CASE
+                                 WHEN @search_mode::text = 'fuzzy' THEN @search::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = @isbn::text OR @isbn::text = '')
+  AND (status = @status::text OR @status::text = '')
+  AND (sqlc.narg('shelf_id')::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = sqlc.narg('shelf_id')))
+  AND (cardinality(@tags::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY (@tags::citext[])) >= CASE WHEN @match_all_tags::bool THEN cardinality(@tags::citext[]) ELSE 1 END)
+ORDER BY CASE WHEN @sort::text = 'name' THEN name END,
+         CASE WHEN @sort::text = '-name' THEN name END DESC,
+         CASE WHEN @sort::text = 'author' THEN author END NULLS LAST,
+         CASE WHEN @sort::text = '-author' THEN author END DESC NULLS LAST,
+         CASE WHEN @sort::text = 'created_at' THEN created_at END,
+         CASE WHEN @sort::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN @sort::text = 'updated_at' THEN updated_at END,
+         CASE WHEN @sort::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN @sort::text = 'rating' THEN (SELECT avg(rating) FROM reviews WHERE reviews.book_id = books.id) END NULLS LAST,
+         CASE WHEN @sort::text = '-rating' THEN (SELECT avg(rating) FROM reviews WHERE reviews.book_id = books.id) END DESC NULLS LAST,
+         CASE WHEN @sort::text = 'relevance' THEN search_rank.rank END DESC,
+         id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListBookForUserByCursor :many
+SELECT sqlc.embed(books),
+       array(SELECT tags.name
+             FROM tags
+                      JOIN book_tags ON book_tags.tag_id = tags.id
+             WHERE book_tags.book_id = books.id
+             ORDER BY tags.name)::text[] AS tags,
+       book_ratings.average_rating,
+       CASE
+           WHEN @search::text = '' THEN NULL
+           ELSE ts_headline('simple', replace(replace(replace(name, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search_query.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') END AS headline,
+       search_rank.rank
+FROM books
+         CROSS JOIN (SELECT CASE
+                                WHEN @search::text = '' THEN NULL
+                                WHEN @search_mode::text = 'prefix' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                 FROM unnest(to_tsvector('simple', @search::text))), ' & '))
+                                WHEN @search_mode::text = 'fuzzy' THEN to_tsquery('simple', array_to_string(array(SELECT quote_literal(lexeme) || ':*'
+                                                                                                                FROM unnest(to_tsvector('simple', @search::text))), ' | '))
+                                ELSE websearch_to_tsquery('simple', @search::text) END AS query) AS search_query
+         CROSS JOIN LATERAL (SELECT CASE
+                                        WHEN @search::text = '' THEN 0
+                                        WHEN @search_mode::text = 'fuzzy' THEN word_similarity(@search::text, name)
+                                        ELSE ts_rank(to_tsvector('simple', name), search_query.query) END::float8 AS rank) AS search_rank
+         LEFT JOIN LATERAL (SELECT avg(reviews.rating) AS average_rating
+                            FROM reviews
+                            WHERE reviews.book_id = books.id) AS book_ratings ON true
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND (@search::text = '' OR CASE
+                                 WHEN @search_mode::text = 'fuzzy' THEN @search::text <% name
+                                 ELSE to_tsvector('simple', name) @@ search_query.query END)
+  AND (isbn = @isbn::text OR @isbn::text = '')
+  AND (status = @status::text OR @status::text = '')
+  AND (sqlc.narg('shelf_id')::uuid IS NULL OR EXISTS(SELECT 1
+                                                      FROM shelf_books
+                                                      WHERE shelf_books.book_id = books.id
+                                                        AND shelf_books.shelf_id = sqlc.narg('shelf_id')))
+  AND (cardinality(@tags::citext[]) = 0 OR
+       (SELECT count(*)
+        FROM book_tags
+                 JOIN tags ON tags.id = book_tags.tag_id
+        WHERE book_tags.book_id = books.id
+          AND tags.name = ANY (@tags::citext[])) >= CASE WHEN @match_all_tags::bool THEN cardinality(@tags::citext[]) ELSE 1 END)
+  AND (sqlc.narg('cursor_id')::uuid IS NULL OR CASE @sort::text
+      WHEN 'name' THEN name > sqlc.narg('cursor_text')::text OR
+                       (name = sqlc.narg('cursor_text')::text AND id > sqlc.narg('cursor_id'))
+      WHEN '-name' THEN name < sqlc.narg('cursor_text')::text OR
+                        (name = sqlc.narg('cursor_text')::text AND id > sqlc.narg('cursor_id'))
+      WHEN 'author' THEN CASE
+                             WHEN sqlc.narg('cursor_text')::text IS NULL THEN author IS NULL AND id > sqlc.narg('cursor_id')
+                             ELSE author > sqlc.narg('cursor_text')::text OR author IS NULL OR
+                                  (author = sqlc.narg('cursor_text')::text AND id > sqlc.narg('cursor_id')) END
+      WHEN '-author' THEN CASE
+                              WHEN sqlc.narg('cursor_text')::text IS NULL THEN author IS NULL AND id > sqlc.narg('cursor_id')
+                              ELSE author < sqlc.narg('cursor_text')::text OR author IS NULL OR
+                                   (author = sqlc.narg('cursor_text')::text AND id > sqlc.narg('cursor_id')) END
+      WHEN 'created_at' THEN created_at > sqlc.narg('cursor_time')::timestamptz OR
+                             (created_at = sqlc.narg('cursor_time')::timestamptz AND id > sqlc.narg('cursor_id'))
+      WHEN '-created_at' THEN created_at < sqlc.narg('cursor_time')::timestamptz OR
+                              (created_at = sqlc.narg('cursor_time')::timestamptz AND id > sqlc.narg('cursor_id'))
+      WHEN 'updated_at' THEN updated_at > sqlc.narg('cursor_time')::timestamptz OR
+                             (updated_at = sqlc.narg('cursor_time')::timestamptz AND id > sqlc.narg('cursor_id'))
+      WHEN '-updated_at' THEN updated_at < sqlc.narg('cursor_time')::timestamptz OR
+                              (updated_at = sqlc.narg('cursor_time')::timestamptz AND id > sqlc.narg('cursor_id'))
+      WHEN 'rating' THEN CASE
+                             WHEN sqlc.narg('cursor_rating')::float8 IS NULL
+                                 THEN book_ratings.average_rating IS NULL AND id > sqlc.narg('cursor_id')
+                             ELSE book_ratings.average_rating > sqlc.narg('cursor_rating')::float8 OR
+                                  book_ratings.average_rating IS NULL OR
+                                  (book_ratings.average_rating = sqlc.narg('cursor_rating')::float8 AND
+                                   id > sqlc.narg('cursor_id')) END
+      WHEN '-rating' THEN CASE
+                              WHEN sqlc.narg('cursor_rating')::float8 IS NULL
+                                  THEN book_ratings.average_rating IS NULL AND id > sqlc.narg('cursor_id')
+                              ELSE book_ratings.average_rating < sqlc.narg('cursor_rating')::float8 OR
+                                   book_ratings.average_rating IS NULL OR
+                                   (book_ratings.average_rating = sqlc.narg('cursor_rating')::float8 AND
+                                    id > sqlc.narg('cursor_id')) END
+      WHEN 'relevance' THEN search_rank.rank < sqlc.narg('cursor_rank')::float8 OR
+                            (search_rank.rank = sqlc.narg('cursor_rank')::float8 AND id > sqlc.narg('cursor_id'))
+      END)
+ORDER BY CASE WHEN @sort::text = 'name' THEN name END,
+         CASE WHEN @sort::text = '-name' THEN name END DESC,
+         CASE WHEN @sort::text = 'author' THEN author END NULLS LAST,
+         CASE WHEN @sort::text = '-author' THEN author END DESC NULLS LAST,
+         CASE WHEN @sort::text = 'created_at' THEN created_at END,
+         CASE WHEN @sort::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN @sort::text = 'updated_at' THEN updated_at END,
+         CASE WHEN @sort::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN @sort::text = 'rating' THEN book_ratings.average_rating END NULLS LAST,
+         CASE WHEN @sort::text = '-rating' THEN book_ratings.average_rating END DESC NULLS LAST,
+         CASE WHEN @sort::text = 'relevance' THEN search_rank.rank END DESC,
+         id
+LIMIT sqlc.arg('limit');
+
+-- name: ListBookForExport :many
+SELECT *
+FROM books
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND (id > sqlc.narg('after_id') OR sqlc.narg('after_id') IS NULL)
+ORDER BY id
+LIMIT @limit;
 
 -- name: GetBook :one
 SELECT *
 FROM books
-WHERE id = $1;
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: GetBookForUserByIsbn :one
+SELECT *
+FROM books
+WHERE user_id = $1
+  AND isbn = $2
+  AND deleted_at IS NULL;
+
+-- name: GetBookForUserByName :one
+SELECT *
+FROM books
+WHERE user_id = $1
+  AND name = $2
+  AND deleted_at IS NULL;
 
 -- name: UpdateBook :one
 UPDATE books
-SET name       = $1,
-    updated_at = now(),
-    version    = version + 1
-WHERE id = $2
+SET name           = $1,
+    author         = $2,
+    isbn           = $3,
+    publisher      = $4,
+    published_year = $5,
+    page_count     = $6,
+    language       = $7,
+    genre          = $8,
+    updated_at     = now(),
+    version        = version + 1
+WHERE id = $9
+  AND version = $10
+  AND user_id = $11
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateBookCover :one
+UPDATE books
+SET cover_key      = $1,
+    cover_format   = $2,
+    cover_blurhash = $3,
+    updated_at     = now(),
+    version        = version + 1
+WHERE id = $4
+  AND version = $5
+  AND user_id = $6
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateReadingProgress :one
+UPDATE books
+SET status           = $1,
+    current_page     = $2,
+    progress_percent = $3,
+    started_at       = $4,
+    finished_at      = $5,
+    updated_at       = now(),
+    version          = version + 1
+WHERE id = $6
+  AND version = $7
+  AND user_id = $8
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashBook :execrows
+UPDATE books
+SET deleted_at = now()
+WHERE id = $1
+  AND user_id = $2
   AND version = $3
-  AND user_id = $4
+  AND deleted_at IS NULL;
+
+-- name: ListTrashedBookForUser :many
+SELECT count(*) OVER () AS total_records,
+       sqlc.embed(books)
+FROM books
+WHERE user_id = @user_id
+  AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetTrashedBook :one
+SELECT *
+FROM books
+WHERE id = $1
+  AND deleted_at IS NOT NULL;
+
+-- name: RestoreBook :one
+UPDATE books
+SET deleted_at = NULL
+WHERE id = $1
+  AND user_id = $2
+  AND deleted_at IS NOT NULL
 RETURNING *;
 
--- name: DeleteBook :exec
+-- name: DeleteTrashedBook :exec
 DELETE
 FROM books
 WHERE id = $1
-  AND user_id = $2;
\ No newline at end of file
+  AND user_id = $2
+  AND deleted_at IS NOT NULL;
+
+-- name: PurgeTrashedBooks :many
+DELETE
+FROM books
+WHERE deleted_at < @trashed_before::timestamptz
+RETURNING cover_key, cover_format;
\ No newline at end of file
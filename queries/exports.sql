@@ -0,0 +1,34 @@
+-- name: CreateExport :one
+INSERT INTO exports(user_id, format)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListExportForUser :many
+SELECT *
+FROM exports
+WHERE user_id = $1
+ORDER BY created_at DESC, id;
+
+-- name: GetExport :one
+SELECT *
+FROM exports
+WHERE id = $1;
+
+-- name: StartExport :exec
+UPDATE exports
+SET status = 'running'
+WHERE id = $1;
+
+-- name: FinishExport :exec
+UPDATE exports
+SET status      = $1,
+    size        = $2,
+    error       = $3,
+    finished_at = now()
+WHERE id = $4;
+
+-- name: PurgeExports :many
+DELETE
+FROM exports
+WHERE finished_at < @finished_before::timestamptz
+RETURNING *;
\ No newline at end of file
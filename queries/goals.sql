@@ -0,0 +1,58 @@
+-- name: CreateGoal :one
+INSERT INTO goals(user_id, year, kind, target)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListGoalForUser :many
+SELECT *
+FROM goals
+WHERE user_id = @user_id
+  AND (year = @year::int OR @year::int = 0)
+ORDER BY year DESC, kind;
+
+-- name: GetGoal :one
+SELECT *
+FROM goals
+WHERE id = $1;
+
+-- name: UpdateGoal :one
+UPDATE goals
+SET target       = $1,
+    completed_at = $2,
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = $3
+  AND version = $4
+  AND user_id = $5
+RETURNING *;
+
+-- name: CompleteGoal :execrows
+UPDATE goals
+SET completed_at = now(),
+    version      = version + 1
+WHERE id = $1
+  AND completed_at IS NULL;
+
+-- name: DeleteGoal :exec
+DELETE
+FROM goals
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: GetGoalProgress :one
+SELECT CASE
+           WHEN @kind::text = 'books' THEN (SELECT count(*)
+                                            FROM books
+                                            WHERE books.user_id = @user_id
+                                              AND books.status = 'finished'
+                                              AND books.deleted_at IS NULL
+                                              AND extract(YEAR FROM books.finished_at) = @year::int)
+           ELSE (SELECT coalesce(sum(pages_read), 0)
+                 FROM reading_sessions
+                 WHERE reading_sessions.user_id = @user_id
+                   AND extract(YEAR FROM reading_sessions.started_at) = @year::int
+                   AND EXISTS(SELECT 1
+                              FROM books
+                              WHERE books.id = reading_sessions.book_id
+                                AND books.deleted_at IS NULL))
+           END::bigint AS progress;
\ No newline at end of file
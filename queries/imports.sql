@@ -0,0 +1,24 @@
+-- name: CreateImport :one
+INSERT INTO imports(user_id, source, rows_total, failed)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetImport :one
+SELECT *
+FROM imports
+WHERE id = $1;
+
+-- name: StartImport :exec
+UPDATE imports
+SET status = 'running'
+WHERE id = $1;
+
+-- name: FinishImport :exec
+UPDATE imports
+SET status        = $1,
+    rows_imported = $2,
+    skipped       = $3,
+    failed        = $4,
+    error         = $5,
+    finished_at   = now()
+WHERE id = $6;
\ No newline at end of file
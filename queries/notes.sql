@@ -0,0 +1,70 @@
+-- name: CreateNote :one
+INSERT INTO notes(book_id, user_id, kind, body, page, chapter, location_start, location_end)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: ListNoteForBook :many
+SELECT count(*) OVER () AS total_records,
+       sqlc.embed(notes)
+FROM notes
+WHERE book_id = @book_id
+  AND (to_tsvector('simple', body) @@ plainto_tsquery('simple', @search) OR @search = '')
+  AND (kind = @kind::text OR @kind::text = '')
+ORDER BY page NULLS LAST, location_start NULLS LAST, created_at, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListNoteForBooks :many
+SELECT *
+FROM notes
+WHERE book_id = ANY (@book_ids::uuid[])
+ORDER BY page NULLS LAST, location_start NULLS LAST, created_at, id;
+
+-- name: GetNote :one
+SELECT *
+FROM notes
+WHERE id = $1
+  AND EXISTS(SELECT 1
+             FROM books
+             WHERE books.id = notes.book_id
+               AND books.deleted_at IS NULL);
+
+-- name: UpdateNote :one
+UPDATE notes
+SET kind           = $1,
+    body           = $2,
+    page           = $3,
+    chapter        = $4,
+    location_start = $5,
+    location_end   = $6,
+    updated_at     = now(),
+    version        = version + 1
+WHERE id = $7
+  AND version = $8
+  AND user_id = $9
+RETURNING *;
+
+-- name: DeleteNote :exec
+DELETE
+FROM notes
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: ImportNote :execrows
+INSERT INTO notes(book_id, user_id, kind, body, page, location_start, location_end, created_at, updated_at)
+SELECT @book_id::uuid,
+       @user_id::uuid,
+       @kind::text,
+       @body::text,
+       sqlc.narg('page')::int,
+       sqlc.narg('location_start')::int,
+       sqlc.narg('location_end')::int,
+       @created_at::timestamptz,
+       @created_at::timestamptz
+WHERE NOT EXISTS(SELECT 1
+                 FROM notes
+                 WHERE book_id = @book_id
+                   AND kind = @kind
+                   AND body = @body
+                   AND page IS NOT DISTINCT FROM sqlc.narg('page')
+                   AND location_start IS NOT DISTINCT FROM sqlc.narg('location_start')
+                   AND location_end IS NOT DISTINCT FROM sqlc.narg('location_end'));
\ No newline at end of file
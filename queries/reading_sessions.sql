@@ -0,0 +1,27 @@
+-- name: CreateReadingSession :one
+INSERT INTO reading_sessions(book_id, user_id, started_at, ended_at, pages_read)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListReadingSessionForBook :many
+SELECT count(*) OVER () AS total_records,
+       sqlc.embed(reading_sessions)
+FROM reading_sessions
+WHERE book_id = @book_id
+ORDER BY started_at DESC, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetReadingSession :one
+SELECT *
+FROM reading_sessions
+WHERE id = $1
+  AND EXISTS(SELECT 1
+             FROM books
+             WHERE books.id = reading_sessions.book_id
+               AND books.deleted_at IS NULL);
+
+-- name: DeleteReadingSession :exec
+DELETE
+FROM reading_sessions
+WHERE id = $1
+  AND user_id = $2;
\ No newline at end of file
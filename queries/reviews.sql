@@ -0,0 +1,56 @@
+-- name: CreateReview :one
+INSERT INTO reviews(book_id, user_id, rating, body, spoiler)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListReviewForBook :many
+SELECT count(*) OVER () AS total_records,
+       sqlc.embed(reviews)
+FROM reviews
+WHERE book_id = @book_id
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: ListReviewForBooks :many
+SELECT *
+FROM reviews
+WHERE book_id = ANY (@book_ids::uuid[])
+ORDER BY created_at, id;
+
+-- name: GetReview :one
+SELECT *
+FROM reviews
+WHERE id = $1
+  AND EXISTS(SELECT 1
+             FROM books
+             WHERE books.id = reviews.book_id
+               AND books.deleted_at IS NULL);
+
+-- name: UpdateReview :one
+UPDATE reviews
+SET rating     = $1,
+    body       = $2,
+    spoiler    = $3,
+    updated_at = now(),
+    version    = version + 1
+WHERE id = $4
+  AND version = $5
+  AND user_id = $6
+RETURNING *;
+
+-- name: DeleteReview :exec
+DELETE
+FROM reviews
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: ListAverageRatingForBooks :many
+SELECT book_id, avg(rating)::double precision AS average_rating
+FROM reviews
+WHERE book_id = ANY (@book_ids::uuid[])
+GROUP BY book_id;
+
+-- name: CountReviewForBook :one
+SELECT count(*)
+FROM reviews
+WHERE book_id = $1;
\ No newline at end of file
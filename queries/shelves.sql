@@ -0,0 +1,56 @@
+-- name: CreateShelf :one
+INSERT INTO shelves(user_id, name, description)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListShelfForUser :many
+SELECT *
+FROM shelves
+WHERE user_id = $1
+ORDER BY name;
+
+-- name: ListShelfNamesForBooks :many
+SELECT shelf_books.book_id, shelves.name
+FROM shelves
+         JOIN shelf_books ON shelf_books.shelf_id = shelves.id
+WHERE shelf_books.book_id = ANY (@book_ids::uuid[])
+ORDER BY shelves.name;
+
+-- name: GetShelf :one
+SELECT *
+FROM shelves
+WHERE id = $1;
+
+-- name: GetShelfForUserByName :one
+SELECT *
+FROM shelves
+WHERE user_id = $1
+  AND name = $2;
+
+-- name: UpdateShelf :one
+UPDATE shelves
+SET name        = $1,
+    description = $2,
+    updated_at  = now(),
+    version     = version + 1
+WHERE id = $3
+  AND version = $4
+  AND user_id = $5
+RETURNING *;
+
+-- name: DeleteShelf :exec
+DELETE
+FROM shelves
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: AddBookToShelf :exec
+INSERT INTO shelf_books(shelf_id, book_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveBookFromShelf :execrows
+DELETE
+FROM shelf_books
+WHERE shelf_id = $1
+  AND book_id = $2;
\ No newline at end of file
@@ -0,0 +1,66 @@
+-- name: CountBooksFinishedPerYear :many
+SELECT extract(YEAR FROM finished_at)::int AS year, count(*) AS books_finished
+FROM books
+WHERE user_id = $1
+  AND status = 'finished'
+  AND deleted_at IS NULL
+  AND finished_at IS NOT NULL
+GROUP BY year
+ORDER BY year;
+
+-- name: CountBooksFinishedPerMonth :many
+SELECT extract(MONTH FROM finished_at)::int AS month, count(*) AS books_finished
+FROM books
+WHERE user_id = @user_id
+  AND status = 'finished'
+  AND deleted_at IS NULL
+  AND extract(YEAR FROM finished_at) = @year::int
+GROUP BY month
+ORDER BY month;
+
+-- name: CountBooksFinishedPerGenre :many
+SELECT genre::text AS genre, count(*) AS books_finished
+FROM books
+WHERE user_id = @user_id
+  AND status = 'finished'
+  AND deleted_at IS NULL
+  AND genre IS NOT NULL
+  AND extract(YEAR FROM finished_at) = @year::int
+GROUP BY genre
+ORDER BY books_finished DESC, genre;
+
+-- name: ListPagesReadPerDay :many
+SELECT started_at::date AS day, sum(pages_read)::bigint AS pages_read
+FROM reading_sessions
+WHERE user_id = @user_id
+  AND extract(YEAR FROM started_at) = @year::int
+  AND EXISTS(SELECT 1
+             FROM books
+             WHERE books.id = reading_sessions.book_id
+               AND books.deleted_at IS NULL)
+GROUP BY day
+ORDER BY day;
+
+-- name: GetReadingTotals :one
+SELECT coalesce(sum(pages_read), 0)::bigint                                AS pages_read,
+       coalesce(sum(extract(EPOCH FROM ended_at - started_at)), 0)::float8 AS reading_seconds
+FROM reading_sessions
+WHERE user_id = @user_id
+  AND extract(YEAR FROM started_at) = @year::int
+  AND EXISTS(SELECT 1
+             FROM books
+             WHERE books.id = reading_sessions.book_id
+               AND books.deleted_at IS NULL);
+
+-- name: GetLongestReadingStreak :one
+WITH reading_days AS (SELECT DISTINCT started_at::date AS day
+                      FROM reading_sessions
+                      WHERE user_id = $1
+                        AND EXISTS(SELECT 1
+                                   FROM books
+                                   WHERE books.id = reading_sessions.book_id
+                                     AND books.deleted_at IS NULL)),
+     streaks AS (SELECT day - (row_number() OVER (ORDER BY day))::int AS streak_start
+                 FROM reading_days)
+SELECT coalesce(max(streak_length), 0)::int AS longest_streak
+FROM (SELECT count(*) AS streak_length FROM streaks GROUP BY streak_start) AS streak_lengths;
\ No newline at end of file
@@ -0,0 +1,26 @@
+-- name: ListBookSuggestions :many
+SELECT id, name
+FROM books
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND name ILIKE @pattern::text
+ORDER BY starts_with(lower(name), lower(@query::text)) DESC, word_similarity(@query::text, name) DESC, name, id
+LIMIT sqlc.arg('limit');
+
+-- name: ListAuthorSuggestions :many
+SELECT author
+FROM books
+WHERE user_id = @user_id
+  AND deleted_at IS NULL
+  AND author ILIKE @pattern::text
+GROUP BY author
+ORDER BY starts_with(lower(author), lower(@query::text)) DESC, word_similarity(@query::text, author) DESC, author
+LIMIT sqlc.arg('limit');
+
+-- name: ListTagSuggestions :many
+SELECT name
+FROM tags
+WHERE user_id = @user_id
+  AND name::text ILIKE @pattern::text
+ORDER BY starts_with(lower(name::text), lower(@query::text)) DESC, word_similarity(@query::text, name::text) DESC, name
+LIMIT sqlc.arg('limit');
\ No newline at end of file
@@ -0,0 +1,50 @@
+-- name: AddTagsToBook :exec
+WITH book_tag_ids AS (
+    INSERT INTO tags (user_id, name)
+        SELECT @user_id::uuid, unnest(@names::text[])
+        ON CONFLICT (user_id, name) DO UPDATE SET name = tags.name
+        RETURNING id)
+INSERT
+INTO book_tags(book_id, tag_id)
+SELECT @book_id::uuid, id
+FROM book_tag_ids
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveTagFromBook :execrows
+DELETE
+FROM book_tags
+    USING tags
+WHERE book_tags.tag_id = tags.id
+  AND book_tags.book_id = $1
+  AND tags.name = $2;
+
+-- name: DeleteUnusedTag :exec
+DELETE
+FROM tags
+WHERE user_id = $1
+  AND name = $2
+  AND NOT EXISTS(SELECT 1 FROM book_tags WHERE book_tags.tag_id = tags.id);
+
+-- name: ListTagsForBook :many
+SELECT tags.name
+FROM tags
+         JOIN book_tags ON book_tags.tag_id = tags.id
+WHERE book_tags.book_id = $1
+ORDER BY tags.name;
+
+-- name: ListTagsForBooks :many
+SELECT book_tags.book_id, tags.name
+FROM tags
+         JOIN book_tags ON book_tags.tag_id = tags.id
+WHERE book_tags.book_id = ANY (@book_ids::uuid[])
+ORDER BY tags.name;
+
+-- name: ListTagsForUser :many
+SELECT tags.name, count(book_tags.book_id) AS book_count
+FROM tags
+         JOIN book_tags ON book_tags.tag_id = tags.id
+         JOIN books ON books.id = book_tags.book_id
+WHERE tags.user_id = $1
+  AND books.deleted_at IS NULL
+GROUP BY tags.id
+ORDER BY tags.name;
\ No newline at end of file
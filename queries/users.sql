@@ -16,4 +16,9 @@ WHERE id = $1;
 -- name: GetUser :one
 SELECT *
 FROM users
+WHERE id = $1;
+
+-- name: GetLibraryModifiedAt :one
+SELECT library_modified_at
+FROM users
 WHERE id = $1;
\ No newline at end of file